@@ -6,6 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/parnurzeal/gorequest"
 )
@@ -78,7 +82,82 @@ type UserRequest struct {
 	Name          string                 `json:"name,omitempty"`
 	Password      string                 `json:"password,omitempty"`
 	UserMetaData  map[string]interface{} `json:"user_metadata,omitempty"`
+	AppMetaData   map[string]interface{} `json:"app_metadata,omitempty"`
 	EmailVerified bool                   `json:"email_verified,omitempty"`
+	Username      string                 `json:"username,omitempty"`
+	PhoneNumber   string                 `json:"phone_number,omitempty"`
+	PhoneVerified *bool                  `json:"phone_verified,omitempty"`
+	GivenName     string                 `json:"given_name,omitempty"`
+	FamilyName    string                 `json:"family_name,omitempty"`
+	Nickname      string                 `json:"nickname,omitempty"`
+	Picture       string                 `json:"picture,omitempty"`
+	Blocked       *bool                  `json:"blocked,omitempty"`
+	VerifyEmail   *bool                  `json:"verify_email,omitempty"`
+
+	CustomPasswordHash *CustomPasswordHash `json:"custom_password_hash,omitempty"`
+
+	// ForceSendFields lists the json names of fields to send even when they hold their zero value.
+	ForceSendFields []string `json:"-"`
+	// NullFields lists the json names of fields to send as null, which clears them in Auth0.
+	NullFields []string `json:"-"`
+}
+
+// CustomPasswordHash imports a password hashed by another system, it can only be set when creating the user.
+type CustomPasswordHash struct {
+	Algorithm string                   `json:"algorithm"`
+	Hash      *CustomPasswordHashValue `json:"hash"`
+	Salt      *CustomPasswordHashSalt  `json:"salt,omitempty"`
+}
+
+type CustomPasswordHashValue struct {
+	Value    string `json:"value"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type CustomPasswordHashSalt struct {
+	Value    string `json:"value"`
+	Encoding string `json:"encoding,omitempty"`
+	Position string `json:"position,omitempty"`
+}
+
+func (ur UserRequest) MarshalJSON() ([]byte, error) {
+	type userRequest UserRequest
+
+	b, err := json.Marshal(userRequest(ur))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalForcedFields(b, reflect.ValueOf(ur), ur.ForceSendFields, ur.NullFields)
+}
+
+// marshalForcedFields adds to the marshalled b the fields of value named in forceSendFields, which omitempty left out,
+// and sets the fields named in nullFields to null.
+func marshalForcedFields(b []byte, value reflect.Value, forceSendFields []string, nullFields []string) ([]byte, error) {
+	if len(forceSendFields) == 0 && len(nullFields) == 0 {
+		return b, nil
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		name := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		for _, forced := range forceSendFields {
+			if forced == name {
+				fields[name] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	for _, name := range nullFields {
+		fields[name] = nil
+	}
+
+	return json.Marshal(fields)
 }
 
 type User struct {
@@ -86,8 +165,19 @@ type User struct {
 	Email         string                 `json:"email,omitempty"`
 	Name          string                 `json:"name,omitempty"`
 	UserMetaData  map[string]interface{} `json:"user_metadata,omitempty"`
+	AppMetaData   map[string]interface{} `json:"app_metadata,omitempty"`
 	EmailVerified bool                   `json:"email_verified,omitempty"`
 	Identities    []Identity             `json:"identities,omitempty"`
+	Username      string                 `json:"username,omitempty"`
+	PhoneNumber   string                 `json:"phone_number,omitempty"`
+	PhoneVerified bool                   `json:"phone_verified,omitempty"`
+	GivenName     string                 `json:"given_name,omitempty"`
+	FamilyName    string                 `json:"family_name,omitempty"`
+	Nickname      string                 `json:"nickname,omitempty"`
+	Picture       string                 `json:"picture,omitempty"`
+	Blocked       bool                   `json:"blocked,omitempty"`
+
+	LastPasswordReset string `json:"last_password_reset,omitempty"`
 }
 
 type Identity struct {
@@ -97,22 +187,157 @@ type Identity struct {
 	IsSocial   bool   `json:"isSocial,omitempty"`
 }
 
+// UnmarshalJSON accepts numeric user ids, which Auth0 returns for some social providers such as GitHub.
+func (i *Identity) UnmarshalJSON(b []byte) error {
+	type identity Identity
+
+	raw := struct {
+		*identity
+		UserId interface{} `json:"user_id,omitempty"`
+	}{identity: (*identity)(i)}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	switch userId := raw.UserId.(type) {
+	case string:
+		i.UserId = userId
+	case json.Number:
+		i.UserId = userId.String()
+	case nil:
+		i.UserId = ""
+	default:
+		return fmt.Errorf("unexpected identity user_id %v", userId)
+	}
+
+	return nil
+}
+
+type UserIdentityLinkRequest struct {
+	Provider     string `json:"provider,omitempty"`
+	UserId       string `json:"user_id,omitempty"`
+	ConnectionId string `json:"connection_id,omitempty"`
+}
+
 type ClientRequest struct {
-	Name                    string                 `json:"name,omitempty"`
-	ApplicationType         string                 `json:"app_type,omitempty"`
-	GrantTypes              []string               `json:"grant_types,omitempty"`
-	TokenEndpointAuthMethod string                 `json:"token_endpoint_auth_method,omitempty"`
-	ClientMetaData          map[string]interface{} `json:"client_metadata,omitempty"`
+	Name                    string                  `json:"name,omitempty"`
+	ApplicationType         string                  `json:"app_type,omitempty"`
+	GrantTypes              []string                `json:"grant_types,omitempty"`
+	TokenEndpointAuthMethod string                  `json:"token_endpoint_auth_method,omitempty"`
+	ClientMetaData          map[string]interface{}  `json:"client_metadata,omitempty"`
+	Description             string                  `json:"description,omitempty"`
+	LogoUri                 string                  `json:"logo_uri,omitempty"`
+	Callbacks               []string                `json:"callbacks"`
+	AllowedOrigins          []string                `json:"allowed_origins"`
+	WebOrigins              []string                `json:"web_origins"`
+	AllowedLogoutUrls       []string                `json:"allowed_logout_urls"`
+	InitiateLoginUri        string                  `json:"initiate_login_uri,omitempty"`
+	OidcConformant          *bool                   `json:"oidc_conformant,omitempty"`
+	IsFirstParty            *bool                   `json:"is_first_party,omitempty"`
+	CrossOriginAuth         *bool                   `json:"cross_origin_auth,omitempty"`
+	CustomLoginPageOn       *bool                   `json:"custom_login_page_on,omitempty"`
+	CustomLoginPage         string                  `json:"custom_login_page,omitempty"`
+	JwtConfiguration        *ClientJwtConfiguration `json:"jwt_configuration,omitempty"`
+	RefreshToken            *ClientRefreshToken     `json:"refresh_token,omitempty"`
+
+	// NullFields lists the json names of fields to send as null, which clears them in Auth0.
+	NullFields []string `json:"-"`
+}
+
+func (cr ClientRequest) MarshalJSON() ([]byte, error) {
+	type clientRequest ClientRequest
+
+	b, err := json.Marshal(clientRequest(cr))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalForcedFields(b, reflect.ValueOf(cr), nil, cr.NullFields)
 }
 
 type Client struct {
-	ClientId                string                 `json:"client_id,omitempty"`
-	ClientSecret            string                 `json:"client_secret,omitempty"`
-	Name                    string                 `json:"name,omitempty"`
-	ApplicationType         string                 `json:"app_type,omitempty"`
-	GrantTypes              []string               `json:"grant_types,omitempty"`
-	TokenEndpointAuthMethod string                 `json:"token_endpoint_auth_method,omitempty"`
-	ClientMetaData          map[string]interface{} `json:"client_metadata,omitempty"`
+	ClientId                    string                       `json:"client_id,omitempty"`
+	ClientSecret                string                       `json:"client_secret,omitempty"`
+	Name                        string                       `json:"name,omitempty"`
+	ApplicationType             string                       `json:"app_type,omitempty"`
+	GrantTypes                  []string                     `json:"grant_types,omitempty"`
+	TokenEndpointAuthMethod     string                       `json:"token_endpoint_auth_method,omitempty"`
+	ClientMetaData              map[string]interface{}       `json:"client_metadata,omitempty"`
+	Description                 string                       `json:"description,omitempty"`
+	LogoUri                     string                       `json:"logo_uri,omitempty"`
+	Callbacks                   []string                     `json:"callbacks,omitempty"`
+	AllowedOrigins              []string                     `json:"allowed_origins,omitempty"`
+	WebOrigins                  []string                     `json:"web_origins,omitempty"`
+	AllowedLogoutUrls           []string                     `json:"allowed_logout_urls,omitempty"`
+	InitiateLoginUri            string                       `json:"initiate_login_uri,omitempty"`
+	OidcConformant              bool                         `json:"oidc_conformant,omitempty"`
+	IsFirstParty                bool                         `json:"is_first_party,omitempty"`
+	CrossOriginAuth             bool                         `json:"cross_origin_auth,omitempty"`
+	CustomLoginPageOn           bool                         `json:"custom_login_page_on,omitempty"`
+	CustomLoginPage             string                       `json:"custom_login_page,omitempty"`
+	JwtConfiguration            *ClientJwtConfiguration      `json:"jwt_configuration,omitempty"`
+	RefreshToken                *ClientRefreshToken          `json:"refresh_token,omitempty"`
+	ClientAuthenticationMethods *ClientAuthenticationMethods `json:"client_authentication_methods,omitempty"`
+}
+
+type ClientJwtConfiguration struct {
+	Alg               string            `json:"alg,omitempty"`
+	LifetimeInSeconds int               `json:"lifetime_in_seconds,omitempty"`
+	Scopes            map[string]string `json:"scopes,omitempty"`
+	SecretEncoded     bool              `json:"secret_encoded,omitempty"`
+}
+
+type ClientRefreshToken struct {
+	RotationType              string `json:"rotation_type,omitempty"`
+	ExpirationType            string `json:"expiration_type,omitempty"`
+	Leeway                    int    `json:"leeway"`
+	TokenLifetime             int    `json:"token_lifetime,omitempty"`
+	InfiniteTokenLifetime     bool   `json:"infinite_token_lifetime"`
+	IdleTokenLifetime         int    `json:"idle_token_lifetime,omitempty"`
+	InfiniteIdleTokenLifetime bool   `json:"infinite_idle_token_lifetime"`
+}
+
+type ClientAuthenticationMethods struct {
+	PrivateKeyJwt *PrivateKeyJwtAuthenticationMethod `json:"private_key_jwt,omitempty"`
+}
+
+type PrivateKeyJwtAuthenticationMethod struct {
+	Credentials []ClientCredentialReference `json:"credentials"`
+}
+
+type ClientCredentialReference struct {
+	Id string `json:"id"`
+}
+
+// ClientAuthenticationMethodsRequest is sent on its own as Auth0 requires token_endpoint_auth_method to be null
+// whenever client_authentication_methods is set, and vice versa.
+type ClientAuthenticationMethodsRequest struct {
+	TokenEndpointAuthMethod     *string                      `json:"token_endpoint_auth_method"`
+	ClientAuthenticationMethods *ClientAuthenticationMethods `json:"client_authentication_methods"`
+}
+
+type ClientCredentialRequest struct {
+	CredentialType      string `json:"credential_type,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Pem                 string `json:"pem,omitempty"`
+	Alg                 string `json:"alg,omitempty"`
+	ParseExpiryFromCert bool   `json:"parse_expiry_from_cert,omitempty"`
+	ExpiresAt           string `json:"expires_at,omitempty"`
+}
+
+type ClientCredential struct {
+	Id             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Kid            string `json:"kid,omitempty"`
+	Alg            string `json:"alg,omitempty"`
+	CredentialType string `json:"credential_type,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	ExpiresAt      string `json:"expires_at,omitempty"`
 }
 
 type ApiRequest struct {
@@ -121,9 +346,21 @@ type ApiRequest struct {
 }
 
 type Api struct {
-	Id         string `json:"id,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Identifier string `json:"identifier,omitempty"`
+	Id                  string     `json:"id,omitempty"`
+	Name                string     `json:"name,omitempty"`
+	Identifier          string     `json:"identifier,omitempty"`
+	Scopes              []ApiScope `json:"scopes,omitempty"`
+	SigningAlg          string     `json:"signing_alg,omitempty"`
+	SigningSecret       string     `json:"signing_secret,omitempty"`
+	TokenLifetime       int        `json:"token_lifetime,omitempty"`
+	TokenLifetimeForWeb int        `json:"token_lifetime_for_web,omitempty"`
+	AllowOfflineAccess  bool       `json:"allow_offline_access,omitempty"`
+	IsSystem            bool       `json:"is_system,omitempty"`
+}
+
+type ApiScope struct {
+	Value       string `json:"value,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
 type ClientGrantRequest struct {
@@ -197,6 +434,32 @@ func (authClient *AuthClient) GetUserById(id string) (*User, error) {
 	return user, nil
 }
 
+// GetUsersByEmail returns all users with the given email address, across every connection.
+func (authClient *AuthClient) GetUsersByEmail(email string) ([]User, error) {
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"users-by-email").
+		Query(map[string]string{"email": email}).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not get users by email from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	users := []User{}
+	err := json.Unmarshal([]byte(body), &users)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get users by email response, error: %v %s", err, body)
+	}
+
+	return users, nil
+}
+
 func (authClient *AuthClient) CreateUser(userRequest *UserRequest) (*User, error) {
 
 	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"users").Send(userRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
@@ -266,6 +529,53 @@ func (authClient *AuthClient) DeleteUserById(id string) error {
 	return nil
 }
 
+// LinkUserIdentity links the identity of a secondary user to the primary user, the secondary user is removed.
+func (authClient *AuthClient) LinkUserIdentity(primaryUserId string, linkRequest *UserIdentityLinkRequest) ([]Identity, error) {
+
+	resp, body, errs := gorequest.New().
+		Post(authClient.config.apiUri+"users/"+primaryUserId+"/identities").
+		Send(linkRequest).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not link identity to auth0 user, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	identities := []Identity{}
+	err := json.Unmarshal([]byte(body), &identities)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 link identity response, error: %v %s", err, body)
+	}
+
+	return identities, nil
+}
+
+// UnlinkUserIdentity unlinks an identity from the primary user, Auth0 turns it back into a separate user.
+func (authClient *AuthClient) UnlinkUserIdentity(primaryUserId string, provider string, userId string) error {
+
+	resp, body, errs := gorequest.New().
+		Delete(authClient.config.apiUri+"users/"+primaryUserId+"/identities/"+provider+"/"+url.PathEscape(userId)).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not unlink identity from auth0 user, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
 // Client
 func (authClient *AuthClient) GetClientById(id string) (*Client, error) {
 
@@ -296,6 +606,59 @@ func (authClient *AuthClient) GetClientById(id string) (*Client, error) {
 	return client, nil
 }
 
+type ClientsPage struct {
+	Start   int      `json:"start"`
+	Limit   int      `json:"limit"`
+	Total   int      `json:"total"`
+	Clients []Client `json:"clients"`
+}
+
+// GetClientIdsByName pages through all clients, only fetching their id and name, and returns the ids of the
+// clients whose name is exactly name.
+func (authClient *AuthClient) GetClientIdsByName(name string) ([]string, error) {
+
+	ids := []string{}
+
+	for page := 0; ; page++ {
+		resp, body, errs := gorequest.New().
+			Get(authClient.config.apiUri+"clients").
+			Query(map[string]string{
+				"fields":         "client_id,name",
+				"include_fields": "true",
+				"include_totals": "true",
+				"page":           strconv.Itoa(page),
+				"per_page":       "50",
+			}).
+			Set("Authorization", authClient.config.getAuthenticationHeader()).
+			Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+			End()
+
+		if errs != nil {
+			return nil, fmt.Errorf("could not get clients from auth0, error: %v", errs)
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+		}
+
+		clientsPage := &ClientsPage{}
+		err := json.Unmarshal([]byte(body), clientsPage)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse auth0 get clients response, error: %v %s", err, body)
+		}
+
+		for _, client := range clientsPage.Clients {
+			if client.Name == name {
+				ids = append(ids, client.ClientId)
+			}
+		}
+
+		if len(clientsPage.Clients) == 0 || clientsPage.Start+len(clientsPage.Clients) >= clientsPage.Total {
+			return ids, nil
+		}
+	}
+}
+
 func (authClient *AuthClient) CreateClient(clientRequest *ClientRequest) (*Client, error) {
 
 	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"clients").Send(clientRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
@@ -322,16 +685,21 @@ func (authClient *AuthClient) CreateClient(clientRequest *ClientRequest) (*Clien
 }
 
 func (authClient *AuthClient) UpdateClientById(id string, clientRequest *ClientRequest) (*Client, error) {
-	resp, body, errs := gorequest.New().Patch(authClient.config.apiUri+"clients/"+id).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
-
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
-	}
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"clients/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(clientRequest).
+		End()
 
 	if errs != nil {
 		return nil, fmt.Errorf("could not update auth0 client, error: %v", errs)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
 	updatedClient := &Client{}
 	err := json.Unmarshal([]byte(body), updatedClient)
 	if err != nil {
@@ -354,6 +722,156 @@ func (authClient *AuthClient) DeleteClientById(id string) error {
 	return nil
 }
 
+func (authClient *AuthClient) UpdateClientAuthenticationMethods(id string, request *ClientAuthenticationMethodsRequest) (*Client, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"clients/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(request).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 client authentication methods, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedClient := &Client{}
+	err := json.Unmarshal([]byte(body), updatedClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 client update response, error: %v", err)
+	}
+
+	return updatedClient, nil
+}
+
+// ClientCredential
+func (authClient *AuthClient) GetClientCredentialById(clientId string, id string) (*ClientCredential, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"clients/"+clientId+"/credentials/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse client credential response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	credential := &ClientCredential{}
+	err := json.Unmarshal([]byte(body), credential)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get client credential response, error: %v %s", err, body)
+	}
+
+	if credential.Id == "" {
+		return nil, nil
+	}
+
+	return credential, nil
+}
+
+func (authClient *AuthClient) CreateClientCredential(clientId string, credentialRequest *ClientCredentialRequest) (*ClientCredential, error) {
+
+	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"clients/"+clientId+"/credentials").Send(credentialRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could create client credential in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	createdCredential := &ClientCredential{}
+	err := json.Unmarshal([]byte(body), createdCredential)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 client credential creation response, error: %v %s", err, body)
+	}
+
+	if createdCredential.Id == "" {
+		return nil, fmt.Errorf("could not create client credential, error: %s", body)
+	}
+
+	return createdCredential, nil
+}
+
+func (authClient *AuthClient) UpdateClientCredentialById(clientId string, id string, credentialRequest *ClientCredentialRequest) (*ClientCredential, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"clients/"+clientId+"/credentials/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(credentialRequest).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 client credential, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedCredential := &ClientCredential{}
+	err := json.Unmarshal([]byte(body), updatedCredential)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 client credential update response, error: %v", err)
+	}
+
+	return updatedCredential, nil
+}
+
+func (authClient *AuthClient) DeleteClientCredentialById(clientId string, id string) error {
+
+	res, body, errs := gorequest.New().Delete(authClient.config.apiUri+"clients/"+clientId+"/credentials/"+id).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+	if errs != nil {
+		return fmt.Errorf("could not delete auth0 client credential, result: %v error: %v", res, errs)
+	}
+
+	if res.StatusCode >= 400 && res.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+// RotateClientSecret replaces the secret of a client, the client_id is left unchanged.
+func (authClient *AuthClient) RotateClientSecret(id string) (*Client, error) {
+
+	resp, body, errs := gorequest.New().
+		Post(authClient.config.apiUri+"clients/"+id+"/rotate-secret").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not rotate auth0 client secret, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	rotatedClient := &Client{}
+	err := json.Unmarshal([]byte(body), rotatedClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 client secret rotation response, error: %v", err)
+	}
+
+	if rotatedClient.ClientSecret == "" {
+		return nil, fmt.Errorf("could not rotate auth0 client secret, error: %v", body)
+	}
+
+	return rotatedClient, nil
+}
+
 // Api
 func (authClient *AuthClient) GetApiById(id string) (*Api, error) {
 
@@ -384,6 +902,11 @@ func (authClient *AuthClient) GetApiById(id string) (*Api, error) {
 	return api, nil
 }
 
+// GetApiByIdentifier looks up an api by its identifier (audience), which Auth0 accepts in place of the id.
+func (authClient *AuthClient) GetApiByIdentifier(identifier string) (*Api, error) {
+	return authClient.GetApiById(url.PathEscape(identifier))
+}
+
 func (authClient *AuthClient) CreateApi(apiRequest *ApiRequest) (*Api, error) {
 
 	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"resource-servers").Send(apiRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
@@ -573,3 +1096,1634 @@ func (authClient *AuthClient) DeleteClientGrantById(id string) error {
 
 	return nil
 }
+
+type TriggerBindingsRequest struct {
+	Bindings []TriggerBindingRequest `json:"bindings"`
+}
+
+type TriggerBindingRequest struct {
+	Ref         TriggerBindingRef `json:"ref"`
+	DisplayName string            `json:"display_name,omitempty"`
+}
+
+type TriggerBindingRef struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type TriggerBindings struct {
+	Bindings []TriggerBinding `json:"bindings"`
+}
+
+type TriggerBindingsPage struct {
+	Total    int              `json:"total"`
+	Page     int              `json:"page"`
+	PerPage  int              `json:"per_page"`
+	Bindings []TriggerBinding `json:"bindings"`
+}
+
+type TriggerBinding struct {
+	Id          string        `json:"id,omitempty"`
+	TriggerId   string        `json:"trigger_id,omitempty"`
+	DisplayName string        `json:"display_name,omitempty"`
+	Action      BindingAction `json:"action"`
+}
+
+type BindingAction struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// TriggerBindings
+func (authClient *AuthClient) GetTriggerBindings(triggerId string) (*TriggerBindings, error) {
+
+	bindings := &TriggerBindings{Bindings: []TriggerBinding{}}
+
+	for page := 0; ; page++ {
+		resp, body, errs := gorequest.New().
+			Get(authClient.config.apiUri+"actions/triggers/"+triggerId+"/bindings").
+			Query(map[string]string{
+				"page":     strconv.Itoa(page),
+				"per_page": "50",
+			}).
+			Set("Authorization", authClient.config.getAuthenticationHeader()).
+			Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+			End()
+
+		if errs != nil {
+			return nil, fmt.Errorf("could not get trigger bindings from auth0, error: %v", errs)
+		}
+
+		if resp.StatusCode == 404 {
+			return nil, nil
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+		}
+
+		bindingsPage := &TriggerBindingsPage{}
+		err := json.Unmarshal([]byte(body), bindingsPage)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse auth0 get trigger bindings response, error: %v %s", err, body)
+		}
+
+		bindings.Bindings = append(bindings.Bindings, bindingsPage.Bindings...)
+
+		if len(bindingsPage.Bindings) == 0 || len(bindings.Bindings) >= bindingsPage.Total {
+			return bindings, nil
+		}
+	}
+}
+
+// UpdateTriggerBindings replaces the full, ordered list of action bindings of a trigger.
+func (authClient *AuthClient) UpdateTriggerBindings(triggerId string, bindingsRequest *TriggerBindingsRequest) (*TriggerBindings, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"actions/triggers/"+triggerId+"/bindings").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(bindingsRequest).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 trigger bindings, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedBindings := &TriggerBindings{}
+	err := json.Unmarshal([]byte(body), updatedBindings)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 trigger bindings update response, error: %v %s", err, body)
+	}
+
+	return updatedBindings, nil
+}
+
+type TenantSettings struct {
+	FriendlyName        string       `json:"friendly_name,omitempty"`
+	SupportEmail        string       `json:"support_email,omitempty"`
+	SupportUrl          string       `json:"support_url,omitempty"`
+	DefaultAudience     string       `json:"default_audience,omitempty"`
+	DefaultDirectory    string       `json:"default_directory,omitempty"`
+	SessionLifetime     float64      `json:"session_lifetime,omitempty"`
+	IdleSessionLifetime float64      `json:"idle_session_lifetime,omitempty"`
+	AllowedLogoutUrls   []string     `json:"allowed_logout_urls,omitempty"`
+	EnabledLocales      []string     `json:"enabled_locales,omitempty"`
+	SandboxVersion      string       `json:"sandbox_version,omitempty"`
+	Flags               *TenantFlags `json:"flags,omitempty"`
+
+	// ForceSendFields lists the json names of fields to send even when they hold their zero value.
+	ForceSendFields []string `json:"-"`
+}
+
+func (ts TenantSettings) MarshalJSON() ([]byte, error) {
+	type tenantSettings TenantSettings
+
+	b, err := json.Marshal(tenantSettings(ts))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalForcedFields(b, reflect.ValueOf(ts), ts.ForceSendFields, nil)
+}
+
+// TenantFlags uses pointers so that flags can be explicitly switched off in a PATCH.
+type TenantFlags struct {
+	EnableClientConnections            *bool `json:"enable_client_connections,omitempty"`
+	EnableApisSection                  *bool `json:"enable_apis_section,omitempty"`
+	EnablePipeline2                    *bool `json:"enable_pipeline2,omitempty"`
+	EnableDynamicClientRegistration    *bool `json:"enable_dynamic_client_registration,omitempty"`
+	EnableCustomDomainInEmails         *bool `json:"enable_custom_domain_in_emails,omitempty"`
+	EnableLegacyLogsSearchV2           *bool `json:"enable_legacy_logs_search_v2,omitempty"`
+	DisableClickjackProtectionHeaders  *bool `json:"disable_clickjack_protection_headers,omitempty"`
+	EnablePublicSignupUserExistsError  *bool `json:"enable_public_signup_user_exists_error,omitempty"`
+	UseScopeDescriptionsForConsent     *bool `json:"use_scope_descriptions_for_consent,omitempty"`
+	RevokeRefreshTokenGrant            *bool `json:"revoke_refresh_token_grant,omitempty"`
+	DisableManagementApiSmsObfuscation *bool `json:"disable_management_api_sms_obfuscation,omitempty"`
+	EnableSso                          *bool `json:"enable_sso,omitempty"`
+}
+
+// Tenant
+func (authClient *AuthClient) GetTenantSettings() (*TenantSettings, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"tenants/settings").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not get tenant settings from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	settings := &TenantSettings{}
+	err := json.Unmarshal([]byte(body), settings)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get tenant settings response, error: %v %s", err, body)
+	}
+
+	return settings, nil
+}
+
+func (authClient *AuthClient) UpdateTenantSettings(settings *TenantSettings) (*TenantSettings, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"tenants/settings").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(settings).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 tenant settings, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedSettings := &TenantSettings{}
+	err := json.Unmarshal([]byte(body), updatedSettings)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 tenant settings update response, error: %v %s", err, body)
+	}
+
+	return updatedSettings, nil
+}
+
+type CustomDomainRequest struct {
+	Domain               string `json:"domain,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	CustomClientIpHeader string `json:"custom_client_ip_header,omitempty"`
+	TlsPolicy            string `json:"tls_policy,omitempty"`
+
+	// NullFields lists the json names of fields to send as null, which clears them in Auth0.
+	NullFields []string `json:"-"`
+}
+
+func (cdr CustomDomainRequest) MarshalJSON() ([]byte, error) {
+	type customDomainRequest CustomDomainRequest
+
+	b, err := json.Marshal(customDomainRequest(cdr))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalForcedFields(b, reflect.ValueOf(cdr), nil, cdr.NullFields)
+}
+
+type CustomDomain struct {
+	CustomDomainId       string                    `json:"custom_domain_id,omitempty"`
+	Domain               string                    `json:"domain,omitempty"`
+	Primary              bool                      `json:"primary,omitempty"`
+	Status               string                    `json:"status,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	OriginDomainName     string                    `json:"origin_domain_name,omitempty"`
+	CnameApiKey          string                    `json:"cname_api_key,omitempty"`
+	CustomClientIpHeader string                    `json:"custom_client_ip_header,omitempty"`
+	TlsPolicy            string                    `json:"tls_policy,omitempty"`
+	Verification         *CustomDomainVerification `json:"verification,omitempty"`
+}
+
+type CustomDomainVerification struct {
+	Methods []CustomDomainVerificationMethod `json:"methods,omitempty"`
+}
+
+type CustomDomainVerificationMethod struct {
+	Name   string `json:"name,omitempty"`
+	Record string `json:"record,omitempty"`
+	Domain string `json:"domain,omitempty"`
+}
+
+// CustomDomain
+func (authClient *AuthClient) GetCustomDomainById(id string) (*CustomDomain, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"custom-domains/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse custom domain response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	customDomain := &CustomDomain{}
+	err := json.Unmarshal([]byte(body), customDomain)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get custom domain response, error: %v %s", err, body)
+	}
+
+	if customDomain.CustomDomainId == "" {
+		return nil, nil
+	}
+
+	return customDomain, nil
+}
+
+func (authClient *AuthClient) CreateCustomDomain(customDomainRequest *CustomDomainRequest) (*CustomDomain, error) {
+
+	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"custom-domains").Send(customDomainRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could create custom domain in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	createdCustomDomain := &CustomDomain{}
+	err := json.Unmarshal([]byte(body), createdCustomDomain)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 custom domain creation response, error: %v %s", err, body)
+	}
+
+	if createdCustomDomain.CustomDomainId == "" {
+		return nil, fmt.Errorf("could not create custom domain, error: %s", body)
+	}
+
+	return createdCustomDomain, nil
+}
+
+func (authClient *AuthClient) UpdateCustomDomainById(id string, customDomainRequest *CustomDomainRequest) (*CustomDomain, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"custom-domains/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(customDomainRequest).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 custom domain, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedCustomDomain := &CustomDomain{}
+	err := json.Unmarshal([]byte(body), updatedCustomDomain)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 custom domain update response, error: %v", err)
+	}
+
+	if updatedCustomDomain.CustomDomainId == "" {
+		return nil, fmt.Errorf("could not update auth0 custom domain, error: %v", body)
+	}
+
+	return updatedCustomDomain, nil
+}
+
+// VerifyCustomDomainById asks Auth0 to check the DNS records of a custom domain, the returned status is "ready"
+// once the domain has been verified.
+func (authClient *AuthClient) VerifyCustomDomainById(id string) (*CustomDomain, error) {
+
+	resp, body, errs := gorequest.New().
+		Post(authClient.config.apiUri+"custom-domains/"+id+"/verify").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not verify auth0 custom domain, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	verifiedCustomDomain := &CustomDomain{}
+	err := json.Unmarshal([]byte(body), verifiedCustomDomain)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 custom domain verification response, error: %v %s", err, body)
+	}
+
+	return verifiedCustomDomain, nil
+}
+
+func (authClient *AuthClient) DeleteCustomDomainById(id string) error {
+
+	res, body, errs := gorequest.New().Delete(authClient.config.apiUri+"custom-domains/"+id).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+	if errs != nil {
+		return fmt.Errorf("could not delete auth0 custom domain, result: %v error: %v", res, errs)
+	}
+
+	if res.StatusCode >= 400 && res.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+type EmailProvider struct {
+	Name               string                    `json:"name,omitempty"`
+	Enabled            *bool                     `json:"enabled,omitempty"`
+	DefaultFromAddress string                    `json:"default_from_address,omitempty"`
+	Credentials        *EmailProviderCredentials `json:"credentials,omitempty"`
+}
+
+type EmailProviderCredentials struct {
+	ApiKey          string `json:"api_key,omitempty"`
+	AccessKeyId     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	Region          string `json:"region,omitempty"`
+	Domain          string `json:"domain,omitempty"`
+	SmtpHost        string `json:"smtp_host,omitempty"`
+	SmtpPort        int    `json:"smtp_port,omitempty"`
+	SmtpUser        string `json:"smtp_user,omitempty"`
+	SmtpPass        string `json:"smtp_pass,omitempty"`
+}
+
+type EmailTemplate struct {
+	Template             string `json:"template,omitempty"`
+	Body                 string `json:"body,omitempty"`
+	From                 string `json:"from,omitempty"`
+	ResultUrl            string `json:"resultUrl,omitempty"`
+	Subject              string `json:"subject,omitempty"`
+	Syntax               string `json:"syntax,omitempty"`
+	UrlLifetimeInSeconds int    `json:"urlLifetimeInSeconds,omitempty"`
+	Enabled              *bool  `json:"enabled,omitempty"`
+
+	// NullFields lists the json names of fields to send as null, which clears them in Auth0.
+	NullFields []string `json:"-"`
+}
+
+func (et EmailTemplate) MarshalJSON() ([]byte, error) {
+	type emailTemplate EmailTemplate
+
+	b, err := json.Marshal(emailTemplate(et))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalForcedFields(b, reflect.ValueOf(et), nil, et.NullFields)
+}
+
+// EmailProvider
+func (authClient *AuthClient) GetEmailProvider() (*EmailProvider, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"emails/provider").
+		Query(map[string]string{"fields": "name,enabled,default_from_address,credentials"}).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse email provider response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	emailProvider := &EmailProvider{}
+	err := json.Unmarshal([]byte(body), emailProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get email provider response, error: %v %s", err, body)
+	}
+
+	if emailProvider.Name == "" {
+		return nil, nil
+	}
+
+	return emailProvider, nil
+}
+
+func (authClient *AuthClient) CreateEmailProvider(emailProvider *EmailProvider) (*EmailProvider, error) {
+
+	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"emails/provider").Send(emailProvider).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could create email provider in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	createdEmailProvider := &EmailProvider{}
+	err := json.Unmarshal([]byte(body), createdEmailProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 email provider creation response, error: %v %s", err, body)
+	}
+
+	return createdEmailProvider, nil
+}
+
+func (authClient *AuthClient) UpdateEmailProvider(emailProvider *EmailProvider) (*EmailProvider, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"emails/provider").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(emailProvider).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 email provider, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedEmailProvider := &EmailProvider{}
+	err := json.Unmarshal([]byte(body), updatedEmailProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 email provider update response, error: %v", err)
+	}
+
+	return updatedEmailProvider, nil
+}
+
+func (authClient *AuthClient) DeleteEmailProvider() error {
+
+	res, body, errs := gorequest.New().Delete(authClient.config.apiUri+"emails/provider").Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+	if errs != nil {
+		return fmt.Errorf("could not delete auth0 email provider, result: %v error: %v", res, errs)
+	}
+
+	if res.StatusCode >= 400 && res.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+// EmailTemplate
+func (authClient *AuthClient) GetEmailTemplate(templateName string) (*EmailTemplate, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"email-templates/"+templateName).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse email template response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	emailTemplate := &EmailTemplate{}
+	err := json.Unmarshal([]byte(body), emailTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get email template response, error: %v %s", err, body)
+	}
+
+	if emailTemplate.Template == "" {
+		return nil, nil
+	}
+
+	return emailTemplate, nil
+}
+
+func (authClient *AuthClient) CreateEmailTemplate(emailTemplate *EmailTemplate) (*EmailTemplate, error) {
+
+	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"email-templates").Send(emailTemplate).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could create email template in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	createdEmailTemplate := &EmailTemplate{}
+	err := json.Unmarshal([]byte(body), createdEmailTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 email template creation response, error: %v %s", err, body)
+	}
+
+	if createdEmailTemplate.Template == "" {
+		return nil, fmt.Errorf("could not create email template, error: %s", body)
+	}
+
+	return createdEmailTemplate, nil
+}
+
+func (authClient *AuthClient) UpdateEmailTemplate(templateName string, emailTemplate *EmailTemplate) (*EmailTemplate, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"email-templates/"+templateName).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(emailTemplate).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 email template, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedEmailTemplate := &EmailTemplate{}
+	err := json.Unmarshal([]byte(body), updatedEmailTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 email template update response, error: %v", err)
+	}
+
+	if updatedEmailTemplate.Template == "" {
+		return nil, fmt.Errorf("could not update auth0 email template, error: %v", body)
+	}
+
+	return updatedEmailTemplate, nil
+}
+
+type Branding struct {
+	Colors     *BrandingColors `json:"colors,omitempty"`
+	FaviconUrl string          `json:"favicon_url,omitempty"`
+	LogoUrl    string          `json:"logo_url,omitempty"`
+	Font       *BrandingFont   `json:"font,omitempty"`
+}
+
+type BrandingColors struct {
+	Primary        string `json:"primary,omitempty"`
+	PageBackground string `json:"page_background,omitempty"`
+}
+
+type BrandingFont struct {
+	Url string `json:"url,omitempty"`
+}
+
+type BrandingTemplateRequest struct {
+	Template string `json:"template"`
+}
+
+type BrandingTemplate struct {
+	Body string `json:"body,omitempty"`
+}
+
+// Branding
+func (authClient *AuthClient) GetBranding() (*Branding, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"branding").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse branding response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	branding := &Branding{}
+	err := json.Unmarshal([]byte(body), branding)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get branding response, error: %v %s", err, body)
+	}
+
+	return branding, nil
+}
+
+func (authClient *AuthClient) UpdateBranding(branding *Branding) (*Branding, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"branding").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(branding).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 branding, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedBranding := &Branding{}
+	err := json.Unmarshal([]byte(body), updatedBranding)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 branding update response, error: %v", err)
+	}
+
+	return updatedBranding, nil
+}
+
+func (authClient *AuthClient) GetUniversalLoginTemplate() (*BrandingTemplate, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"branding/templates/universal-login").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse universal login template response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	template := &BrandingTemplate{}
+	err := json.Unmarshal([]byte(body), template)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get universal login template response, error: %v %s", err, body)
+	}
+
+	if template.Body == "" {
+		return nil, nil
+	}
+
+	return template, nil
+}
+
+func (authClient *AuthClient) SetUniversalLoginTemplate(templateRequest *BrandingTemplateRequest) error {
+
+	resp, body, errs := gorequest.New().
+		Put(authClient.config.apiUri+"branding/templates/universal-login").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(templateRequest).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not set auth0 universal login template, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (authClient *AuthClient) DeleteUniversalLoginTemplate() error {
+
+	res, body, errs := gorequest.New().Delete(authClient.config.apiUri+"branding/templates/universal-login").Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+	if errs != nil {
+		return fmt.Errorf("could not delete auth0 universal login template, result: %v error: %v", res, errs)
+	}
+
+	if res.StatusCode >= 400 && res.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+// PromptCustomText
+func (authClient *AuthClient) GetPromptCustomText(prompt string, language string) (map[string]interface{}, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"prompts/"+prompt+"/custom-text/"+language).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse prompt custom text response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	customText := map[string]interface{}{}
+	err := json.Unmarshal([]byte(body), &customText)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get prompt custom text response, error: %v %s", err, body)
+	}
+
+	return customText, nil
+}
+
+// SetPromptCustomText replaces all the custom text of a prompt in the given language.
+func (authClient *AuthClient) SetPromptCustomText(prompt string, language string, customText map[string]interface{}) error {
+	reqJSON, err := json.Marshal(customText)
+	if err != nil {
+		return fmt.Errorf("failed to marshal prompt custom text: %v", err)
+	}
+
+	resp, body, errs := gorequest.New().
+		Put(authClient.config.apiUri+"prompts/"+prompt+"/custom-text/"+language).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		SendString(string(reqJSON)).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not set auth0 prompt custom text, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+type LogStreamRequest struct {
+	Name    string            `json:"name,omitempty"`
+	Type    string            `json:"type,omitempty"`
+	Status  string            `json:"status,omitempty"`
+	Filters []LogStreamFilter `json:"filters"`
+	Sink    *LogStreamSink    `json:"sink,omitempty"`
+}
+
+type LogStream struct {
+	Id      string            `json:"id,omitempty"`
+	Name    string            `json:"name,omitempty"`
+	Type    string            `json:"type,omitempty"`
+	Status  string            `json:"status,omitempty"`
+	Filters []LogStreamFilter `json:"filters,omitempty"`
+	Sink    *LogStreamSink    `json:"sink,omitempty"`
+}
+
+type LogStreamFilter struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// LogStreamSink holds the settings of every sink type, only the ones matching the type of the stream are set.
+type LogStreamSink struct {
+	HttpEndpoint          string `json:"httpEndpoint,omitempty"`
+	HttpAuthorization     string `json:"httpAuthorization,omitempty"`
+	HttpContentFormat     string `json:"httpContentFormat,omitempty"`
+	HttpContentType       string `json:"httpContentType,omitempty"`
+	AwsAccountId          string `json:"awsAccountId,omitempty"`
+	AwsRegion             string `json:"awsRegion,omitempty"`
+	AwsPartnerEventSource string `json:"awsPartnerEventSource,omitempty"`
+	DatadogRegion         string `json:"datadogRegion,omitempty"`
+	DatadogApiKey         string `json:"datadogApiKey,omitempty"`
+	SplunkDomain          string `json:"splunkDomain,omitempty"`
+	SplunkToken           string `json:"splunkToken,omitempty"`
+	SplunkPort            string `json:"splunkPort,omitempty"`
+	SplunkSecure          *bool  `json:"splunkSecure,omitempty"`
+	SumoSourceAddress     string `json:"sumoSourceAddress,omitempty"`
+}
+
+// LogStream
+func (authClient *AuthClient) GetLogStreamById(id string) (*LogStream, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"log-streams/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could parse log stream response from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode != 404 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	logStream := &LogStream{}
+	err := json.Unmarshal([]byte(body), logStream)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get log stream response, error: %v %s", err, body)
+	}
+
+	if logStream.Id == "" {
+		return nil, nil
+	}
+
+	return logStream, nil
+}
+
+func (authClient *AuthClient) CreateLogStream(logStreamRequest *LogStreamRequest) (*LogStream, error) {
+
+	resp, body, errs := gorequest.New().Post(authClient.config.apiUri+"log-streams").Send(logStreamRequest).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could create log stream in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	createdLogStream := &LogStream{}
+	err := json.Unmarshal([]byte(body), createdLogStream)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 log stream creation response, error: %v %s", err, body)
+	}
+
+	if createdLogStream.Id == "" {
+		return nil, fmt.Errorf("could not create log stream, error: %s", body)
+	}
+
+	return createdLogStream, nil
+}
+
+func (authClient *AuthClient) UpdateLogStreamById(id string, logStreamRequest *LogStreamRequest) (*LogStream, error) {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"log-streams/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(logStreamRequest).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not update auth0 log stream, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	updatedLogStream := &LogStream{}
+	err := json.Unmarshal([]byte(body), updatedLogStream)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 log stream update response, error: %v", err)
+	}
+
+	if updatedLogStream.Id == "" {
+		return nil, fmt.Errorf("could not update auth0 log stream, error: %v", body)
+	}
+
+	return updatedLogStream, nil
+}
+
+func (authClient *AuthClient) DeleteLogStreamById(id string) error {
+
+	res, body, errs := gorequest.New().Delete(authClient.config.apiUri+"log-streams/"+id).Set("Authorization", authClient.config.getAuthenticationHeader()).End()
+	if errs != nil {
+		return fmt.Errorf("could not delete auth0 log stream, result: %v error: %v", res, errs)
+	}
+
+	if res.StatusCode >= 400 && res.StatusCode != 404 {
+		return fmt.Errorf("bad status code (%d): %s", res.StatusCode, body)
+	}
+
+	return nil
+}
+
+type BruteForceProtection struct {
+	Enabled     bool     `json:"enabled"`
+	Shields     []string `json:"shields"`
+	Allowlist   []string `json:"allowlist"`
+	Mode        string   `json:"mode,omitempty"`
+	MaxAttempts int      `json:"max_attempts,omitempty"`
+}
+
+type BreachedPasswordDetection struct {
+	Enabled                    bool     `json:"enabled"`
+	Shields                    []string `json:"shields"`
+	AdminNotificationFrequency []string `json:"admin_notification_frequency"`
+	Method                     string   `json:"method,omitempty"`
+}
+
+type SuspiciousIpThrottling struct {
+	Enabled   bool                         `json:"enabled"`
+	Shields   []string                     `json:"shields"`
+	Allowlist []string                     `json:"allowlist"`
+	Stage     *SuspiciousIpThrottlingStage `json:"stage,omitempty"`
+}
+
+type SuspiciousIpThrottlingStage struct {
+	PreLogin            *SuspiciousIpThrottlingStageLimits `json:"pre-login,omitempty"`
+	PreUserRegistration *SuspiciousIpThrottlingStageLimits `json:"pre-user-registration,omitempty"`
+}
+
+type SuspiciousIpThrottlingStageLimits struct {
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	Rate        int `json:"rate,omitempty"`
+}
+
+// AttackProtection
+func (authClient *AuthClient) getAttackProtection(path string, result interface{}) error {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"attack-protection/"+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could parse %s response from auth0, error: %v", path, errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	err := json.Unmarshal([]byte(body), result)
+	if err != nil {
+		return fmt.Errorf("could not parse auth0 get %s response, error: %v %s", path, err, body)
+	}
+
+	return nil
+}
+
+func (authClient *AuthClient) updateAttackProtection(path string, request interface{}, result interface{}) error {
+
+	resp, body, errs := gorequest.New().
+		Patch(authClient.config.apiUri+"attack-protection/"+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		Send(request).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not update auth0 %s, error: %v", path, errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	err := json.Unmarshal([]byte(body), result)
+	if err != nil {
+		return fmt.Errorf("could not parse auth0 %s update response, error: %v", path, err)
+	}
+
+	return nil
+}
+
+func (authClient *AuthClient) GetBruteForceProtection() (*BruteForceProtection, error) {
+	bruteForceProtection := &BruteForceProtection{}
+	err := authClient.getAttackProtection("brute-force-protection", bruteForceProtection)
+	if err != nil {
+		return nil, err
+	}
+
+	return bruteForceProtection, nil
+}
+
+func (authClient *AuthClient) UpdateBruteForceProtection(bruteForceProtection *BruteForceProtection) (*BruteForceProtection, error) {
+	updated := &BruteForceProtection{}
+	err := authClient.updateAttackProtection("brute-force-protection", bruteForceProtection, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (authClient *AuthClient) GetBreachedPasswordDetection() (*BreachedPasswordDetection, error) {
+	breachedPasswordDetection := &BreachedPasswordDetection{}
+	err := authClient.getAttackProtection("breached-password-detection", breachedPasswordDetection)
+	if err != nil {
+		return nil, err
+	}
+
+	return breachedPasswordDetection, nil
+}
+
+func (authClient *AuthClient) UpdateBreachedPasswordDetection(breachedPasswordDetection *BreachedPasswordDetection) (*BreachedPasswordDetection, error) {
+	updated := &BreachedPasswordDetection{}
+	err := authClient.updateAttackProtection("breached-password-detection", breachedPasswordDetection, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (authClient *AuthClient) GetSuspiciousIpThrottling() (*SuspiciousIpThrottling, error) {
+	suspiciousIpThrottling := &SuspiciousIpThrottling{}
+	err := authClient.getAttackProtection("suspicious-ip-throttling", suspiciousIpThrottling)
+	if err != nil {
+		return nil, err
+	}
+
+	return suspiciousIpThrottling, nil
+}
+
+func (authClient *AuthClient) UpdateSuspiciousIpThrottling(suspiciousIpThrottling *SuspiciousIpThrottling) (*SuspiciousIpThrottling, error) {
+	updated := &SuspiciousIpThrottling{}
+	err := authClient.updateAttackProtection("suspicious-ip-throttling", suspiciousIpThrottling, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+type GuardianFactor struct {
+	Name         string `json:"name,omitempty"`
+	Enabled      bool   `json:"enabled"`
+	TrialExpired bool   `json:"trial_expired,omitempty"`
+}
+
+type GuardianSelectedProvider struct {
+	Provider string `json:"provider"`
+}
+
+type GuardianTwilioSettings struct {
+	From                string `json:"from,omitempty"`
+	MessagingServiceSid string `json:"messaging_service_sid,omitempty"`
+	AuthToken           string `json:"auth_token,omitempty"`
+	Sid                 string `json:"sid,omitempty"`
+}
+
+type GuardianApnsSettings struct {
+	Sandbox  bool   `json:"sandbox"`
+	BundleId string `json:"bundle_id,omitempty"`
+	P12      string `json:"p12,omitempty"`
+}
+
+type GuardianFcmSettings struct {
+	ServerKey string `json:"server_key,omitempty"`
+}
+
+type GuardianSnsSettings struct {
+	AwsAccessKeyId                string `json:"aws_access_key_id,omitempty"`
+	AwsSecretAccessKey            string `json:"aws_secret_access_key,omitempty"`
+	AwsRegion                     string `json:"aws_region,omitempty"`
+	SnsApnsPlatformApplicationArn string `json:"sns_apns_platform_application_arn,omitempty"`
+	SnsGcmPlatformApplicationArn  string `json:"sns_gcm_platform_application_arn,omitempty"`
+}
+
+// Guardian
+func (authClient *AuthClient) getGuardian(path string, result interface{}) error {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"guardian/"+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could parse guardian %s response from auth0, error: %v", path, errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	err := json.Unmarshal([]byte(body), result)
+	if err != nil {
+		return fmt.Errorf("could not parse auth0 get guardian %s response, error: %v %s", path, err, body)
+	}
+
+	return nil
+}
+
+func (authClient *AuthClient) setGuardian(method string, path string, request interface{}) error {
+	reqJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal guardian %s request: %v", path, err)
+	}
+
+	resp, body, errs := gorequest.New().
+		CustomMethod(method, authClient.config.apiUri+"guardian/"+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Set("Content-Type", "application/json").
+		SendString(string(reqJSON)).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not update auth0 guardian %s, error: %v", path, errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// GetGuardianPolicies returns the policies deciding when MFA is required, no policy means MFA is never required.
+func (authClient *AuthClient) GetGuardianPolicies() ([]string, error) {
+	policies := make([]string, 0)
+	err := authClient.getGuardian("policies", &policies)
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (authClient *AuthClient) SetGuardianPolicies(policies []string) error {
+	return authClient.setGuardian(http.MethodPut, "policies", policies)
+}
+
+func (authClient *AuthClient) GetGuardianFactors() ([]GuardianFactor, error) {
+	factors := make([]GuardianFactor, 0)
+	err := authClient.getGuardian("factors", &factors)
+	if err != nil {
+		return nil, err
+	}
+
+	return factors, nil
+}
+
+func (authClient *AuthClient) SetGuardianFactorEnabled(name string, enabled bool) error {
+	return authClient.setGuardian(http.MethodPut, "factors/"+name, &GuardianFactor{Enabled: enabled})
+}
+
+func (authClient *AuthClient) GetGuardianSmsSelectedProvider() (string, error) {
+	selected := &GuardianSelectedProvider{}
+	err := authClient.getGuardian("factors/sms/selected-provider", selected)
+	if err != nil {
+		return "", err
+	}
+
+	return selected.Provider, nil
+}
+
+func (authClient *AuthClient) SetGuardianSmsSelectedProvider(provider string) error {
+	return authClient.setGuardian(http.MethodPut, "factors/sms/selected-provider", &GuardianSelectedProvider{Provider: provider})
+}
+
+func (authClient *AuthClient) GetGuardianTwilioSettings() (*GuardianTwilioSettings, error) {
+	settings := &GuardianTwilioSettings{}
+	err := authClient.getGuardian("factors/sms/providers/twilio", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (authClient *AuthClient) SetGuardianTwilioSettings(settings *GuardianTwilioSettings) error {
+	return authClient.setGuardian(http.MethodPut, "factors/sms/providers/twilio", settings)
+}
+
+func (authClient *AuthClient) GetGuardianPushSelectedProvider() (string, error) {
+	selected := &GuardianSelectedProvider{}
+	err := authClient.getGuardian("factors/push-notification/selected-provider", selected)
+	if err != nil {
+		return "", err
+	}
+
+	return selected.Provider, nil
+}
+
+func (authClient *AuthClient) SetGuardianPushSelectedProvider(provider string) error {
+	return authClient.setGuardian(http.MethodPut, "factors/push-notification/selected-provider", &GuardianSelectedProvider{Provider: provider})
+}
+
+func (authClient *AuthClient) GetGuardianApnsSettings() (*GuardianApnsSettings, error) {
+	settings := &GuardianApnsSettings{}
+	err := authClient.getGuardian("factors/push-notification/providers/apns", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (authClient *AuthClient) SetGuardianApnsSettings(settings *GuardianApnsSettings) error {
+	return authClient.setGuardian(http.MethodPut, "factors/push-notification/providers/apns", settings)
+}
+
+func (authClient *AuthClient) SetGuardianFcmSettings(settings *GuardianFcmSettings) error {
+	return authClient.setGuardian(http.MethodPatch, "factors/push-notification/providers/fcm", settings)
+}
+
+func (authClient *AuthClient) GetGuardianSnsSettings() (*GuardianSnsSettings, error) {
+	settings := &GuardianSnsSettings{}
+	err := authClient.getGuardian("factors/push-notification/providers/sns", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (authClient *AuthClient) SetGuardianSnsSettings(settings *GuardianSnsSettings) error {
+	return authClient.setGuardian(http.MethodPut, "factors/push-notification/providers/sns", settings)
+}
+
+type Job struct {
+	Id           string      `json:"id,omitempty"`
+	Type         string      `json:"type,omitempty"`
+	Status       string      `json:"status,omitempty"`
+	ConnectionId string      `json:"connection_id,omitempty"`
+	Connection   string      `json:"connection,omitempty"`
+	CreatedAt    string      `json:"created_at,omitempty"`
+	Location     string      `json:"location,omitempty"`
+	Summary      *JobSummary `json:"summary,omitempty"`
+}
+
+type JobSummary struct {
+	Failed   int `json:"failed"`
+	Updated  int `json:"updated"`
+	Inserted int `json:"inserted"`
+	Total    int `json:"total"`
+}
+
+// JobUserError lists why a user of an import job could not be imported.
+type JobUserError struct {
+	User   map[string]interface{} `json:"user,omitempty"`
+	Errors []JobError             `json:"errors,omitempty"`
+}
+
+type JobError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+type UsersImportJobRequest struct {
+	ConnectionId        string
+	Users               []byte
+	Upsert              bool
+	ExternalId          string
+	SendCompletionEmail bool
+}
+
+type UsersExportJobRequest struct {
+	ConnectionId string             `json:"connection_id,omitempty"`
+	Format       string             `json:"format,omitempty"`
+	Limit        int                `json:"limit,omitempty"`
+	Fields       []UsersExportField `json:"fields,omitempty"`
+}
+
+type UsersExportField struct {
+	Name     string `json:"name"`
+	ExportAs string `json:"export_as,omitempty"`
+}
+
+// Jobs
+func (authClient *AuthClient) GetJobById(id string) (*Job, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"jobs/"+id).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not get job from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	job := &Job{}
+	err := json.Unmarshal([]byte(body), job)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get job response, error: %v %s", err, body)
+	}
+
+	return job, nil
+}
+
+// GetJobErrors returns the users an import job failed to import, along with the reasons why.
+func (authClient *AuthClient) GetJobErrors(id string) ([]JobUserError, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+"jobs/"+id+"/errors").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not get job errors from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	// Auth0 answers with no content when the job has no errors
+	if resp.StatusCode == http.StatusNoContent || body == "" {
+		return nil, nil
+	}
+
+	jobErrors := []JobUserError{}
+	err := json.Unmarshal([]byte(body), &jobErrors)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get job errors response, error: %v %s", err, body)
+	}
+
+	return jobErrors, nil
+}
+
+// CreateUsersImportJob uploads a JSON file of users to import into a database connection.
+func (authClient *AuthClient) CreateUsersImportJob(importRequest *UsersImportJobRequest) (*Job, error) {
+
+	fields := map[string]interface{}{
+		"connection_id":         importRequest.ConnectionId,
+		"upsert":                importRequest.Upsert,
+		"send_completion_email": importRequest.SendCompletionEmail,
+	}
+
+	if importRequest.ExternalId != "" {
+		fields["external_id"] = importRequest.ExternalId
+	}
+
+	resp, body, errs := gorequest.New().
+		Post(authClient.config.apiUri+"jobs/users-imports").
+		Type("multipart").
+		Send(fields).
+		SendFile(importRequest.Users, "users.json", "users").
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not create users import job in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	job := &Job{}
+	err := json.Unmarshal([]byte(body), job)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 create users import job response, error: %v %s", err, body)
+	}
+
+	return job, nil
+}
+
+func (authClient *AuthClient) CreateUsersExportJob(exportRequest *UsersExportJobRequest) (*Job, error) {
+
+	resp, body, errs := gorequest.New().
+		Post(authClient.config.apiUri+"jobs/users-exports").
+		Send(exportRequest).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not create users export job in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	job := &Job{}
+	err := json.Unmarshal([]byte(body), job)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 create users export job response, error: %v %s", err, body)
+	}
+
+	return job, nil
+}
+
+// DownloadJobResult downloads the gzipped file produced by an export job, its location is a pre-signed url so it
+// is fetched without the management api token.
+func (authClient *AuthClient) DownloadJobResult(location string) ([]byte, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(location).
+		EndBytes()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not download job result from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// Permission grants a permission (scope) of a resource server, directly to a user or to a role.
+type Permission struct {
+	ResourceServerIdentifier string `json:"resource_server_identifier"`
+	PermissionName           string `json:"permission_name"`
+	ResourceServerName       string `json:"resource_server_name,omitempty"`
+	Description              string `json:"description,omitempty"`
+}
+
+type PermissionsRequest struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+type PermissionsPage struct {
+	Start       int          `json:"start"`
+	Limit       int          `json:"limit"`
+	Total       int          `json:"total"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Permissions
+func (authClient *AuthClient) GetUserPermissions(userId string) ([]Permission, error) {
+	return authClient.getPermissions("users/" + userId + "/permissions")
+}
+
+func (authClient *AuthClient) AddUserPermissions(userId string, permissions []Permission) error {
+	return authClient.updatePermissions(http.MethodPost, "users/"+userId+"/permissions", permissions)
+}
+
+func (authClient *AuthClient) RemoveUserPermissions(userId string, permissions []Permission) error {
+	return authClient.updatePermissions(http.MethodDelete, "users/"+userId+"/permissions", permissions)
+}
+
+func (authClient *AuthClient) GetRolePermissions(roleId string) ([]Permission, error) {
+	return authClient.getPermissions("roles/" + roleId + "/permissions")
+}
+
+func (authClient *AuthClient) AddRolePermissions(roleId string, permissions []Permission) error {
+	return authClient.updatePermissions(http.MethodPost, "roles/"+roleId+"/permissions", permissions)
+}
+
+func (authClient *AuthClient) RemoveRolePermissions(roleId string, permissions []Permission) error {
+	return authClient.updatePermissions(http.MethodDelete, "roles/"+roleId+"/permissions", permissions)
+}
+
+// getPermissions pages through all the permissions at path, it returns nil when the user or role doesn't exist.
+func (authClient *AuthClient) getPermissions(path string) ([]Permission, error) {
+
+	permissions := []Permission{}
+
+	for page := 0; ; page++ {
+		resp, body, errs := gorequest.New().
+			Get(authClient.config.apiUri+path).
+			Query(map[string]string{
+				"include_totals": "true",
+				"page":           strconv.Itoa(page),
+				"per_page":       "100",
+			}).
+			Set("Authorization", authClient.config.getAuthenticationHeader()).
+			Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+			End()
+
+		if errs != nil {
+			return nil, fmt.Errorf("could not get permissions from auth0, error: %v", errs)
+		}
+
+		if resp.StatusCode == 404 {
+			return nil, nil
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+		}
+
+		permissionsPage := &PermissionsPage{}
+		err := json.Unmarshal([]byte(body), permissionsPage)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse auth0 get permissions response, error: %v %s", err, body)
+		}
+
+		permissions = append(permissions, permissionsPage.Permissions...)
+
+		if len(permissionsPage.Permissions) == 0 || permissionsPage.Start+len(permissionsPage.Permissions) >= permissionsPage.Total {
+			return permissions, nil
+		}
+	}
+}
+
+func (authClient *AuthClient) updatePermissions(method string, path string, permissions []Permission) error {
+
+	resp, body, errs := gorequest.New().
+		CustomMethod(method, authClient.config.apiUri+path).
+		Send(&PermissionsRequest{Permissions: permissions}).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not update permissions in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// UserBlocks lists the brute-force protection blocks of a user, one per IP address it is blocked for.
+type UserBlocks struct {
+	BlockedFor []UserBlock `json:"blocked_for"`
+}
+
+type UserBlock struct {
+	Identifier string `json:"identifier,omitempty"`
+	Ip         string `json:"ip,omitempty"`
+	Connection string `json:"connection,omitempty"`
+}
+
+// UserBlocks
+func (authClient *AuthClient) GetUserBlocksByIdentifier(identifier string) (*UserBlocks, error) {
+	return authClient.getUserBlocks("user-blocks?identifier=" + url.QueryEscape(identifier))
+}
+
+func (authClient *AuthClient) GetUserBlocksByUserId(userId string) (*UserBlocks, error) {
+	return authClient.getUserBlocks("user-blocks/" + userId)
+}
+
+// UnblockUserByIdentifier clears the brute-force protection blocks of every user with the identifier (email,
+// username or phone number), it doesn't unblock users blocked through their blocked attribute.
+func (authClient *AuthClient) UnblockUserByIdentifier(identifier string) error {
+	return authClient.deleteUserBlocks("user-blocks?identifier=" + url.QueryEscape(identifier))
+}
+
+func (authClient *AuthClient) UnblockUserByUserId(userId string) error {
+	return authClient.deleteUserBlocks("user-blocks/" + userId)
+}
+
+func (authClient *AuthClient) getUserBlocks(path string) (*UserBlocks, error) {
+
+	resp, body, errs := gorequest.New().
+		Get(authClient.config.apiUri+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return nil, fmt.Errorf("could not get user blocks from auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	userBlocks := &UserBlocks{}
+	err := json.Unmarshal([]byte(body), userBlocks)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse auth0 get user blocks response, error: %v %s", err, body)
+	}
+
+	return userBlocks, nil
+}
+
+func (authClient *AuthClient) deleteUserBlocks(path string) error {
+
+	resp, body, errs := gorequest.New().
+		Delete(authClient.config.apiUri+path).
+		Set("Authorization", authClient.config.getAuthenticationHeader()).
+		Retry(authClient.config.maxRetryCount, authClient.config.timeBetweenRetries, http.StatusTooManyRequests).
+		End()
+
+	if errs != nil {
+		return fmt.Errorf("could not delete user blocks in auth0, error: %v", errs)
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("bad status code (%d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
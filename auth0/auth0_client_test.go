@@ -1,6 +1,7 @@
 package auth0
 
 import (
+	"encoding/json"
 	"os"
 	"sync"
 	"testing"
@@ -98,3 +99,117 @@ func TestAccGetUserByIdIsNotRateLimited(t *testing.T) {
 		}
 	}
 }
+
+func TestUserRequestMarshalJSONSendsForcedAndNullFields(t *testing.T) {
+	userRequest := UserRequest{
+		Connection:      "Username-Password-Authentication",
+		EmailVerified:   false,
+		ForceSendFields: []string{"email_verified"},
+		NullFields:      []string{"given_name"},
+	}
+
+	b, err := json.Marshal(userRequest)
+	if err != nil {
+		t.Fatalf("failed to marshal user request %v", err)
+	}
+
+	expected := `{"connection":"Username-Password-Authentication","email_verified":false,"given_name":null}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestUserRequestMarshalJSONOmitsEmptyFields(t *testing.T) {
+	b, err := json.Marshal(&UserRequest{Name: "user1234"})
+	if err != nil {
+		t.Fatalf("failed to marshal user request %v", err)
+	}
+
+	expected := `{"name":"user1234"}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestCustomDomainRequestMarshalJSONSendsNullFields(t *testing.T) {
+	customDomainRequest := CustomDomainRequest{
+		TlsPolicy:  "recommended",
+		NullFields: []string{"custom_client_ip_header"},
+	}
+
+	b, err := json.Marshal(customDomainRequest)
+	if err != nil {
+		t.Fatalf("failed to marshal custom domain request %v", err)
+	}
+
+	expected := `{"custom_client_ip_header":null,"tls_policy":"recommended"}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestTenantSettingsMarshalJSONSendsForcedEmptyLists(t *testing.T) {
+	enabled := false
+	settings := TenantSettings{
+		AllowedLogoutUrls: []string{},
+		Flags:             &TenantFlags{EnableSso: &enabled},
+		ForceSendFields:   []string{"allowed_logout_urls"},
+	}
+
+	b, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatalf("failed to marshal tenant settings %v", err)
+	}
+
+	expected := `{"allowed_logout_urls":[],"flags":{"enable_sso":false}}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestIdentityUnmarshalJSONAcceptsNumericUserId(t *testing.T) {
+	var user User
+
+	err := json.Unmarshal([]byte(`{"user_id":"github|1234567","identities":[{"connection":"github","user_id":1234567,"provider":"github","isSocial":true},{"connection":"Username-Password-Authentication","user_id":"5f1c","provider":"auth0"}]}`), &user)
+	if err != nil {
+		t.Fatalf("failed to unmarshal user %v", err)
+	}
+
+	expected := []Identity{
+		{Connection: "github", UserId: "1234567", Provider: "github", IsSocial: true},
+		{Connection: "Username-Password-Authentication", UserId: "5f1c", Provider: "auth0"},
+	}
+	if len(user.Identities) != len(expected) {
+		t.Fatalf("expected %d identities, got %+v", len(expected), user.Identities)
+	}
+
+	for i, identity := range expected {
+		if user.Identities[i] != identity {
+			t.Fatalf("expected %+v, got %+v", identity, user.Identities[i])
+		}
+	}
+}
+
+func TestEmailTemplateMarshalJSONSendsNullFields(t *testing.T) {
+	b, err := json.Marshal(EmailTemplate{Template: "welcome_email", NullFields: []string{"resultUrl", "urlLifetimeInSeconds"}})
+	if err != nil {
+		t.Fatalf("failed to marshal email template %v", err)
+	}
+
+	expected := `{"resultUrl":null,"template":"welcome_email","urlLifetimeInSeconds":null}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestClientRequestMarshalJSONSendsNullFields(t *testing.T) {
+	b, err := json.Marshal(&ClientRequest{Name: "test client", NullFields: []string{"description"}})
+	if err != nil {
+		t.Fatalf("failed to marshal client request %v", err)
+	}
+
+	expected := `{"allowed_logout_urls":null,"allowed_origins":null,"callbacks":null,"description":null,"name":"test client","web_origins":null}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, b)
+	}
+}
@@ -0,0 +1,119 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAuth0Api looks up an existing api (resource server) by its identifier or id.
+func dataSourceAuth0Api() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuth0ApiRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"api_id", "identifier"},
+			},
+			"identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scopes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"signing_alg": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_lifetime": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"token_lifetime_for_web": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"allow_offline_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAuth0ApiRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	var api *Api
+	var err error
+
+	lookup := readStringFromResource(d, "api_id")
+	if lookup != "" {
+		api, err = auth0Client.GetApiById(lookup)
+	} else {
+		lookup = d.Get("identifier").(string)
+		api, err = auth0Client.GetApiByIdentifier(lookup)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 api: %v", err)
+	}
+
+	if api == nil {
+		return fmt.Errorf("no auth0 api found matching %q", lookup)
+	}
+
+	d.SetId(api.Id)
+	d.Set("api_id", api.Id)
+	d.Set("identifier", api.Identifier)
+	d.Set("name", api.Name)
+	d.Set("scopes", flattenApiScopes(api.Scopes))
+	d.Set("signing_alg", api.SigningAlg)
+	d.Set("signing_secret", api.SigningSecret)
+	d.Set("token_lifetime", api.TokenLifetime)
+	d.Set("token_lifetime_for_web", api.TokenLifetimeForWeb)
+	d.Set("allow_offline_access", api.AllowOfflineAccess)
+
+	return nil
+}
+
+func flattenApiScopes(scopes []ApiScope) []interface{} {
+	result := make([]interface{}, 0, len(scopes))
+
+	for _, scope := range scopes {
+		result = append(result, map[string]interface{}{
+			"value":       scope.Value,
+			"description": scope.Description,
+		})
+	}
+
+	return result
+}
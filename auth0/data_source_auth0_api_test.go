@@ -0,0 +1,42 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuth0Api(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceApiConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_api.by_identifier", "id", "auth0_api.test_api", "id"),
+					resource.TestCheckResourceAttr("data.auth0_api.by_identifier", "name", "test data source api"),
+					resource.TestCheckResourceAttr("data.auth0_api.by_identifier", "signing_alg", "RS256"),
+					resource.TestCheckResourceAttrPair("data.auth0_api.by_id", "identifier", "auth0_api.test_api", "identifier"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceApiConfig = `
+
+resource "auth0_api" "test_api" {
+	name 		= "test data source api"
+	identifier 	= "https://data-source-api.example.com/"
+}
+
+data "auth0_api" "by_identifier" {
+	identifier = auth0_api.test_api.identifier
+}
+
+data "auth0_api" "by_id" {
+	api_id = auth0_api.test_api.id
+}
+
+`
@@ -0,0 +1,143 @@
+package auth0
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAuth0Client looks up an existing client by client_id or by its exact name.
+func dataSourceAuth0Client() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuth0ClientRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"client_id", "name"},
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"app_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grant_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"token_endpoint_auth_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logo_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"callbacks": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"allowed_origins": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"web_origins": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"allowed_logout_urls": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"initiate_login_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"oidc_conformant": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_first_party": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAuth0ClientRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	clientId := readStringFromResource(d, "client_id")
+
+	if clientId == "" {
+		name := d.Get("name").(string)
+
+		ids, err := auth0Client.GetClientIdsByName(name)
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 client: %v", err)
+		}
+
+		if len(ids) == 0 {
+			return fmt.Errorf("no auth0 client found with name %q", name)
+		}
+
+		if len(ids) > 1 {
+			return fmt.Errorf("found %d auth0 clients with name %q (%s), use client_id instead", len(ids), name, strings.Join(ids, ", "))
+		}
+
+		clientId = ids[0]
+	}
+
+	client, err := auth0Client.GetClientById(clientId)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 client: %v", err)
+	}
+
+	if client == nil {
+		return fmt.Errorf("no auth0 client found with client_id %q", clientId)
+	}
+
+	d.SetId(client.ClientId)
+	d.Set("client_id", client.ClientId)
+	d.Set("name", client.Name)
+	d.Set("app_type", client.ApplicationType)
+	d.Set("grant_types", client.GrantTypes)
+	d.Set("token_endpoint_auth_method", client.TokenEndpointAuthMethod)
+	d.Set("client_metadata", client.ClientMetaData)
+	d.Set("description", client.Description)
+	d.Set("logo_uri", client.LogoUri)
+	d.Set("callbacks", client.Callbacks)
+	d.Set("allowed_origins", client.AllowedOrigins)
+	d.Set("web_origins", client.WebOrigins)
+	d.Set("allowed_logout_urls", client.AllowedLogoutUrls)
+	d.Set("initiate_login_uri", client.InitiateLoginUri)
+	d.Set("oidc_conformant", client.OidcConformant)
+	d.Set("is_first_party", client.IsFirstParty)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package auth0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuth0Client(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceClientConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_client.by_name", "client_id", "auth0_client.test_client", "id"),
+					resource.TestCheckResourceAttr("data.auth0_client.by_name", "app_type", "non_interactive"),
+					resource.TestCheckResourceAttr("data.auth0_client.by_name", "description", "test data source client"),
+					resource.TestCheckResourceAttrPair("data.auth0_client.by_id", "name", "auth0_client.test_client", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAuth0ClientNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceClientNotFoundConfig,
+				ExpectError: regexp.MustCompile(`no auth0 client found with name`),
+			},
+		},
+	})
+}
+
+const testDataSourceClientConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test data source client"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+	description 				= "test data source client"
+}
+
+data "auth0_client" "by_name" {
+	name = auth0_client.test_client.name
+}
+
+data "auth0_client" "by_id" {
+	client_id = auth0_client.test_client.id
+}
+
+`
+
+const testDataSourceClientNotFoundConfig = `
+
+data "auth0_client" "missing" {
+	name = "this client does not exist"
+}
+
+`
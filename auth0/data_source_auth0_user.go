@@ -0,0 +1,180 @@
+package auth0
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAuth0User looks up an existing user by user_id, or by email optionally restricted to a connection.
+func dataSourceAuth0User() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuth0UserRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "email"},
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_type": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"user_id"},
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Only holds the string values of user_metadata, user_metadata_json holds all of it.
+			"user_metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"user_metadata_json": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Only holds the string values of app_metadata, app_metadata_json holds all of it.
+			"app_metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"app_metadata_json": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"given_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"family_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nickname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"picture": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blocked": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAuth0UserRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	userId := readStringFromResource(d, "user_id")
+
+	if userId == "" {
+		email := d.Get("email").(string)
+		connection := readStringFromResource(d, "connection_type")
+
+		users, err := auth0Client.GetUsersByEmail(email)
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 user: %v", err)
+		}
+
+		ids := []string{}
+		for _, user := range users {
+			if connection == "" || userHasConnection(user, connection) {
+				ids = append(ids, user.UserId)
+			}
+		}
+
+		if len(ids) == 0 {
+			return fmt.Errorf("no auth0 user found with email %q", email)
+		}
+
+		if len(ids) > 1 {
+			return fmt.Errorf("found %d auth0 users with email %q (%s), set connection_type or use user_id instead", len(ids), email, strings.Join(ids, ", "))
+		}
+
+		userId = ids[0]
+	}
+
+	user, err := auth0Client.GetUserById(userId)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user: %v", err)
+	}
+
+	if user == nil {
+		return fmt.Errorf("no auth0 user found with user_id %q", userId)
+	}
+
+	d.SetId(user.UserId)
+	d.Set("user_id", user.UserId)
+	d.Set("email", user.Email)
+	d.Set("name", user.Name)
+
+	for key, metadata := range map[string]map[string]interface{}{
+		"user_metadata": user.UserMetaData,
+		"app_metadata":  user.AppMetaData,
+	} {
+		flat, _ := flattenUserMetadata(metadata)
+		d.Set(key, flat)
+
+		metadataJson, err := flattenUserMetadataJson(metadata)
+		if err != nil {
+			return fmt.Errorf("could not serialise %s: %v", key, err)
+		}
+		d.Set(key+"_json", metadataJson)
+	}
+
+	d.Set("email_verified", user.EmailVerified)
+	d.Set("username", user.Username)
+	d.Set("phone_number", user.PhoneNumber)
+	d.Set("given_name", user.GivenName)
+	d.Set("family_name", user.FamilyName)
+	d.Set("nickname", user.Nickname)
+	d.Set("picture", user.Picture)
+	d.Set("blocked", user.Blocked)
+
+	if len(user.Identities) > 0 {
+		d.Set("connection_type", user.Identities[0].Connection)
+	}
+
+	return nil
+}
+
+func userHasConnection(user User, connection string) bool {
+	for _, identity := range user.Identities {
+		if identity.Connection == connection {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAuth0UserBlocks lists the brute-force protection blocks of a user, by user_id or by identifier.
+func dataSourceAuth0UserBlocks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAuth0UserBlocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "identifier"},
+			},
+			// Email, username or phone number, the blocks of every user with this identifier are listed.
+			"identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"blocked_for": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuth0UserBlocksRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	userBlocks, err := readUserBlocks(auth0Client, d)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user blocks: %v", err)
+	}
+
+	key := "identifier"
+	if readStringFromResource(d, "user_id") != "" {
+		key = "user_id"
+	}
+
+	if userBlocks == nil {
+		return fmt.Errorf("no auth0 user found with %s %q", key, readStringFromResource(d, key))
+	}
+
+	d.SetId(key + ":" + readStringFromResource(d, key))
+
+	d.Set("blocked_for", flattenUserBlocks(userBlocks.BlockedFor))
+
+	return nil
+}
+
+func flattenUserBlocks(userBlocks []UserBlock) []interface{} {
+	result := make([]interface{}, 0, len(userBlocks))
+
+	for _, userBlock := range userBlocks {
+		result = append(result, map[string]interface{}{
+			"identifier": userBlock.Identifier,
+			"ip":         userBlock.Ip,
+			"connection": userBlock.Connection,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+package auth0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuth0UserBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceUserBlocksConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_user_blocks.test_blocks", "id", "identifier:never-blocked@example.com"),
+					resource.TestCheckResourceAttr("data.auth0_user_blocks.test_blocks", "blocked_for.#", "0"),
+				),
+			},
+			{
+				Config:      testDataSourceUnknownUserBlocksConfig,
+				ExpectError: regexp.MustCompile(`no auth0 user found with user_id "auth0\|unknown-user"`),
+			},
+		},
+	})
+}
+
+const testDataSourceUserBlocksConfig = `
+
+data "auth0_user_blocks" "test_blocks" {
+	identifier = "never-blocked@example.com"
+}
+
+`
+
+const testDataSourceUnknownUserBlocksConfig = `
+
+data "auth0_user_blocks" "test_blocks" {
+	user_id = "auth0|unknown-user"
+}
+
+`
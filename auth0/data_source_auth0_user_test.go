@@ -0,0 +1,53 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAuth0User(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.auth0_user.by_email", "user_id", "auth0_user.test_user", "id"),
+					resource.TestCheckResourceAttr("data.auth0_user.by_email", "name", "Data Source User"),
+					resource.TestCheckResourceAttr("data.auth0_user.by_email", "connection_type", "Username-Password-Authentication"),
+					resource.TestCheckResourceAttrPair("data.auth0_user.by_id", "email", "auth0_user.test_user", "email"),
+					resource.TestCheckResourceAttr("data.auth0_user.by_id", "user_metadata_json", `{"limits":{"seats":3},"plan":"free"}`),
+					resource.TestCheckResourceAttr("data.auth0_user.by_id", "user_metadata.%", "1"),
+					resource.TestCheckResourceAttr("data.auth0_user.by_id", "user_metadata.plan", "free"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceUserConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "data-source-user@example.com"
+	name 			= "Data Source User"
+	password 		= "Passw0rd!Test"
+	user_metadata_json = jsonencode({
+		plan 	= "free"
+		limits 	= { seats = 3 }
+	})
+}
+
+data "auth0_user" "by_email" {
+	email 			= auth0_user.test_user.email
+	connection_type = "Username-Password-Authentication"
+}
+
+data "auth0_user" "by_id" {
+	user_id = auth0_user.test_user.id
+}
+
+`
@@ -0,0 +1,118 @@
+package auth0
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceAuth0UsersExport runs a users export job every time it is read and writes the exported users to a
+// local file, with 0600 permissions as it holds personal data.
+func dataSourceAuth0UsersExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuth0UsersExportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Users of every connection are exported when not set.
+			"connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// With "json" the file holds one user per line.
+			"format": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "csv"}, false),
+			},
+			// Names of the user fields to export, Auth0 exports its default set of fields when not set.
+			"fields": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAuth0UsersExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	auth0Client := meta.(*AuthClient)
+
+	exportRequest := &UsersExportJobRequest{
+		ConnectionId: readStringFromResource(d, "connection_id"),
+		Format:       d.Get("format").(string),
+		Limit:        d.Get("limit").(int),
+	}
+
+	for _, name := range readStringArrayFromResource(d, "fields") {
+		exportRequest.Fields = append(exportRequest.Fields, UsersExportField{Name: name})
+	}
+
+	job, err := auth0Client.CreateUsersExportJob(exportRequest)
+
+	if err != nil {
+		return diag.Errorf("failed to create auth0 users export job: %v", err)
+	}
+
+	job, err = waitForAuth0Job(ctx, auth0Client, job.Id, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return diag.Errorf("auth0 users export job did not complete: %v", err)
+	}
+
+	// A completed export without location means no user matched
+	users := []byte{}
+
+	if job.Location != "" {
+		compressed, err := auth0Client.DownloadJobResult(job.Location)
+
+		if err != nil {
+			return diag.Errorf("could not download auth0 users export: %v", err)
+		}
+
+		reader, err := gzip.NewReader(bytes.NewReader(compressed))
+
+		if err != nil {
+			return diag.Errorf("could not decompress auth0 users export: %v", err)
+		}
+
+		users, err = io.ReadAll(reader)
+
+		if err != nil {
+			return diag.Errorf("could not decompress auth0 users export: %v", err)
+		}
+	}
+
+	outputFile := d.Get("output_file").(string)
+
+	err = os.WriteFile(outputFile, users, 0600)
+
+	if err != nil {
+		return diag.Errorf("could not write auth0 users export to %s: %v", outputFile, err)
+	}
+
+	d.SetId(job.Id)
+
+	return nil
+}
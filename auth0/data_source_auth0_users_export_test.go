@@ -0,0 +1,57 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceAuth0UsersExport(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "users.json")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testDataSourceUsersExportConfig, outputFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.auth0_users_export.test_export", "id"),
+					testAccCheckUsersExportWritten(outputFile),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsersExportWritten(path string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return fmt.Errorf("could not find users export file: %v", err)
+		}
+
+		if info.Mode().Perm() != 0600 {
+			return fmt.Errorf("expected users export file %s to have 0600 permissions, got %v", path, info.Mode().Perm())
+		}
+
+		return nil
+	}
+}
+
+const testDataSourceUsersExportConfig = `
+
+data "auth0_users_export" "test_export" {
+	format 		= "json"
+	fields 		= ["user_id", "email"]
+	limit 		= 10
+	output_file = "%s"
+}
+
+`
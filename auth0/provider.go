@@ -43,10 +43,42 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"auth0_user":         resourceAuth0User(),
-			"auth0_client":       resourceAuth0Client(),
-			"auth0_api":          resourceAuth0Api(),
-			"auth0_client_grant": resourceAuth0ClientGrant(),
+			"auth0_user":                        resourceAuth0User(),
+			"auth0_user_identity_link":          resourceAuth0UserIdentityLink(),
+			"auth0_users_import_job":            resourceAuth0UsersImportJob(),
+			"auth0_user_permissions":            resourceAuth0UserPermissions(),
+			"auth0_role_permissions":            resourceAuth0RolePermissions(),
+			"auth0_user_block_clearance":        resourceAuth0UserBlockClearance(),
+			"auth0_client":                      resourceAuth0Client(),
+			"auth0_api":                         resourceAuth0Api(),
+			"auth0_client_grant":                resourceAuth0ClientGrant(),
+			"auth0_client_secret_rotation":      resourceAuth0ClientSecretRotation(),
+			"auth0_client_credential":           resourceAuth0ClientCredential(),
+			"auth0_trigger_binding":             resourceAuth0TriggerBinding(),
+			"auth0_tenant":                      resourceAuth0Tenant(),
+			"auth0_custom_domain":               resourceAuth0CustomDomain(),
+			"auth0_custom_domain_verification":  resourceAuth0CustomDomainVerification(),
+			"auth0_email":                       resourceAuth0Email(),
+			"auth0_email_template":              resourceAuth0EmailTemplate(),
+			"auth0_branding":                    resourceAuth0Branding(),
+			"auth0_branding_template":           resourceAuth0BrandingTemplate(),
+			"auth0_prompt_custom_text":          resourceAuth0PromptCustomText(),
+			"auth0_log_stream":                  resourceAuth0LogStream(),
+			"auth0_brute_force_protection":      resourceAuth0BruteForceProtection(),
+			"auth0_breached_password_detection": resourceAuth0BreachedPasswordDetection(),
+			"auth0_suspicious_ip_throttling":    resourceAuth0SuspiciousIpThrottling(),
+			"auth0_guardian_policy":             resourceAuth0GuardianPolicy(),
+			"auth0_guardian_factor":             resourceAuth0GuardianFactor(),
+			"auth0_guardian_sms_provider":       resourceAuth0GuardianSmsProvider(),
+			"auth0_guardian_push_provider":      resourceAuth0GuardianPushProvider(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"auth0_client":       dataSourceAuth0Client(),
+			"auth0_api":          dataSourceAuth0Api(),
+			"auth0_user":         dataSourceAuth0User(),
+			"auth0_users_export": dataSourceAuth0UsersExport(),
+			"auth0_user_blocks":  dataSourceAuth0UserBlocks(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -0,0 +1,165 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// brandingId is the ID given to the singleton branding resource.
+const brandingId = "branding"
+
+func resourceAuth0Branding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0BrandingCreate,
+		Read:   resourceAuth0BrandingRead,
+		Update: resourceAuth0BrandingUpdate,
+		Delete: resourceAuth0BrandingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"colors": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"page_background": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"logo_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"favicon_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"font": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0BrandingCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	branding := createBrandingFromResourceData(d)
+
+	_, err := auth0Client.UpdateBranding(branding)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 branding: %v error: %v", branding, err)
+	}
+
+	d.SetId(brandingId)
+
+	return resourceAuth0BrandingRead(d, meta)
+}
+
+func resourceAuth0BrandingRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	branding, err := auth0Client.GetBranding()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 branding: %v", err)
+	}
+
+	d.Set("logo_url", branding.LogoUrl)
+	d.Set("favicon_url", branding.FaviconUrl)
+
+	if branding.Colors != nil {
+		d.Set("colors", []interface{}{map[string]interface{}{
+			"primary":         branding.Colors.Primary,
+			"page_background": branding.Colors.PageBackground,
+		}})
+	}
+
+	if branding.Font != nil {
+		d.Set("font", []interface{}{map[string]interface{}{
+			"url": branding.Font.Url,
+		}})
+	}
+
+	return nil
+}
+
+func resourceAuth0BrandingUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	branding := createBrandingFromResourceData(d)
+
+	_, err := auth0Client.UpdateBranding(branding)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 branding: %v error: %v", branding, err)
+	}
+
+	return resourceAuth0BrandingRead(d, meta)
+}
+
+// resourceAuth0BrandingDelete only forgets the branding, a tenant always has branding settings.
+func resourceAuth0BrandingDelete(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId("")
+
+	return nil
+}
+
+func createBrandingFromResourceData(d *schema.ResourceData) *Branding {
+	branding := &Branding{}
+
+	branding.LogoUrl = readStringFromResource(d, "logo_url")
+	branding.FaviconUrl = readStringFromResource(d, "favicon_url")
+
+	if colorsList := d.Get("colors").([]interface{}); len(colorsList) > 0 && colorsList[0] != nil {
+		colors := colorsList[0].(map[string]interface{})
+
+		branding.Colors = &BrandingColors{
+			Primary:        colors["primary"].(string),
+			PageBackground: colors["page_background"].(string),
+		}
+	}
+
+	if fontList := d.Get("font").([]interface{}); len(fontList) > 0 && fontList[0] != nil {
+		font := fontList[0].(map[string]interface{})
+
+		branding.Font = &BrandingFont{
+			Url: font["url"].(string),
+		}
+	}
+
+	return branding
+}
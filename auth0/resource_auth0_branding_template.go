@@ -0,0 +1,81 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// brandingTemplateId is the ID given to the universal login template, the only template Auth0 supports.
+const brandingTemplateId = "universal-login"
+
+func resourceAuth0BrandingTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0BrandingTemplateSet,
+		Read:   resourceAuth0BrandingTemplateRead,
+		Update: resourceAuth0BrandingTemplateSet,
+		Delete: resourceAuth0BrandingTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"body": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0BrandingTemplateSet(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	templateRequest := &BrandingTemplateRequest{
+		Template: readStringFromResource(d, "body"),
+	}
+
+	err := auth0Client.SetUniversalLoginTemplate(templateRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to set auth0 universal login template: %v", err)
+	}
+
+	d.SetId(brandingTemplateId)
+
+	return resourceAuth0BrandingTemplateRead(d, meta)
+}
+
+func resourceAuth0BrandingTemplateRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	template, err := auth0Client.GetUniversalLoginTemplate()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 universal login template: %v", err)
+	}
+
+	if template == nil {
+		d.SetId("")
+	} else {
+		d.Set("body", template.Body)
+	}
+
+	return nil
+}
+
+func resourceAuth0BrandingTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.DeleteUniversalLoginTemplate()
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 universal login template: %v", err)
+	}
+
+	return nil
+}
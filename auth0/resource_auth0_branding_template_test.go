@@ -0,0 +1,55 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0BrandingTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0BrandingTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateBrandingTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding_template.test_template", "body", "<!DOCTYPE html><html><head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body></html>"),
+				),
+			},
+			{
+				ResourceName:      "auth0_branding_template.test_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0BrandingTemplateDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	response, err := client.GetUniversalLoginTemplate()
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 universal login template: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("universal login template still exists, %+v", response)
+	}
+
+	return nil
+}
+
+const testCreateBrandingTemplateConfig = `
+
+resource "auth0_branding_template" "test_template" {
+	body = "<!DOCTYPE html><html><head>{%- auth0:head -%}</head><body>{%- auth0:widget -%}</body></html>"
+}
+
+`
@@ -0,0 +1,65 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0Branding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateBrandingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "colors.0.primary", "#0059d6"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "colors.0.page_background", "#000000"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "logo_url", "https://example.com/logo.png"),
+				),
+			},
+			{
+				Config: testUpdateBrandingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "colors.0.primary", "#ff0000"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "colors.0.page_background", "#ffffff"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "logo_url", "https://example.com/logo-v2.png"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "favicon_url", "https://example.com/favicon.ico"),
+					resource.TestCheckResourceAttr("auth0_branding.test_branding", "font.0.url", "https://example.com/font.woff"),
+				),
+			},
+		},
+	})
+}
+
+const testCreateBrandingConfig = `
+
+resource "auth0_branding" "test_branding" {
+	logo_url = "https://example.com/logo.png"
+
+	colors {
+		primary 		= "#0059d6"
+		page_background = "#000000"
+	}
+}
+
+`
+
+const testUpdateBrandingConfig = `
+
+resource "auth0_branding" "test_branding" {
+	logo_url 	= "https://example.com/logo-v2.png"
+	favicon_url = "https://example.com/favicon.ico"
+
+	colors {
+		primary 		= "#ff0000"
+		page_background = "#ffffff"
+	}
+
+	font {
+		url = "https://example.com/font.woff"
+	}
+}
+
+`
@@ -0,0 +1,110 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const breachedPasswordDetectionId = "breached-password-detection"
+
+func resourceAuth0BreachedPasswordDetection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0BreachedPasswordDetectionUpdate,
+		Read:   resourceAuth0BreachedPasswordDetectionRead,
+		Update: resourceAuth0BreachedPasswordDetectionUpdate,
+		Delete: resourceAuth0BreachedPasswordDetectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"shields": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"block", "user_notification", "admin_notification"}, false),
+				},
+				Optional: true,
+			},
+			"admin_notification_frequency": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"immediately", "daily", "weekly", "monthly"}, false),
+				},
+				Optional: true,
+			},
+			"method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "enhanced"}, false),
+			},
+		},
+	}
+}
+
+func resourceAuth0BreachedPasswordDetectionRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	breachedPasswordDetection, err := auth0Client.GetBreachedPasswordDetection()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 breached password detection: %v", err)
+	}
+
+	d.Set("enabled", breachedPasswordDetection.Enabled)
+	d.Set("shields", breachedPasswordDetection.Shields)
+	d.Set("admin_notification_frequency", breachedPasswordDetection.AdminNotificationFrequency)
+	d.Set("method", breachedPasswordDetection.Method)
+
+	return nil
+}
+
+func resourceAuth0BreachedPasswordDetectionUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	breachedPasswordDetection := &BreachedPasswordDetection{
+		Enabled:                    d.Get("enabled").(bool),
+		Shields:                    readStringSetFromResource(d, "shields"),
+		AdminNotificationFrequency: readStringSetFromResource(d, "admin_notification_frequency"),
+		Method:                     readStringFromResource(d, "method"),
+	}
+
+	_, err := auth0Client.UpdateBreachedPasswordDetection(breachedPasswordDetection)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 breached password detection: %v error: %v", breachedPasswordDetection, err)
+	}
+
+	d.SetId(breachedPasswordDetectionId)
+
+	return resourceAuth0BreachedPasswordDetectionRead(d, meta)
+}
+
+// resourceAuth0BreachedPasswordDetectionDelete disables the detection, the settings themselves cannot be removed.
+func resourceAuth0BreachedPasswordDetectionDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	_, err := auth0Client.UpdateBreachedPasswordDetection(&BreachedPasswordDetection{
+		Enabled:                    false,
+		Shields:                    readStringSetFromResource(d, "shields"),
+		AdminNotificationFrequency: readStringSetFromResource(d, "admin_notification_frequency"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not disable auth0 breached password detection: %v", err)
+	}
+
+	return nil
+}
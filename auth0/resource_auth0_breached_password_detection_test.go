@@ -0,0 +1,53 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0BreachedPasswordDetection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateBreachedPasswordDetectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "admin_notification_frequency.#", "1"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "method", "standard"),
+				),
+			},
+			{
+				Config: testUpdateBreachedPasswordDetectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "enabled", "false"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_breached_password_detection.test_detection", "admin_notification_frequency.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testCreateBreachedPasswordDetectionConfig = `
+
+resource "auth0_breached_password_detection" "test_detection" {
+	enabled 						= true
+	shields 						= ["block", "admin_notification"]
+	admin_notification_frequency 	= ["daily"]
+	method 							= "standard"
+}
+
+`
+
+const testUpdateBreachedPasswordDetectionConfig = `
+
+resource "auth0_breached_password_detection" "test_detection" {
+	enabled = false
+	shields = ["block"]
+}
+
+`
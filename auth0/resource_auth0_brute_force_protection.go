@@ -0,0 +1,121 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const bruteForceProtectionId = "brute-force-protection"
+
+func resourceAuth0BruteForceProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0BruteForceProtectionUpdate,
+		Read:   resourceAuth0BruteForceProtectionRead,
+		Update: resourceAuth0BruteForceProtectionUpdate,
+		Delete: resourceAuth0BruteForceProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"shields": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"block", "user_notification"}, false),
+				},
+				Optional: true,
+			},
+			"allowlist": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIpAddressOrCidr,
+				},
+				Optional: true,
+			},
+			"mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"count_per_identifier_and_ip", "count_per_identifier"}, false),
+			},
+			"max_attempts": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+// validateIpAddressOrCidr accepts both single addresses and ranges, as Auth0 allow lists do.
+var validateIpAddressOrCidr = validation.Any(validation.IsIPAddress, validation.IsCIDR)
+
+func resourceAuth0BruteForceProtectionRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	bruteForceProtection, err := auth0Client.GetBruteForceProtection()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 brute-force protection: %v", err)
+	}
+
+	d.Set("enabled", bruteForceProtection.Enabled)
+	d.Set("shields", bruteForceProtection.Shields)
+	d.Set("allowlist", bruteForceProtection.Allowlist)
+	d.Set("mode", bruteForceProtection.Mode)
+	d.Set("max_attempts", bruteForceProtection.MaxAttempts)
+
+	return nil
+}
+
+func resourceAuth0BruteForceProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	bruteForceProtection := &BruteForceProtection{
+		Enabled:     d.Get("enabled").(bool),
+		Shields:     readStringSetFromResource(d, "shields"),
+		Allowlist:   readStringSetFromResource(d, "allowlist"),
+		Mode:        readStringFromResource(d, "mode"),
+		MaxAttempts: d.Get("max_attempts").(int),
+	}
+
+	_, err := auth0Client.UpdateBruteForceProtection(bruteForceProtection)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 brute-force protection: %v error: %v", bruteForceProtection, err)
+	}
+
+	d.SetId(bruteForceProtectionId)
+
+	return resourceAuth0BruteForceProtectionRead(d, meta)
+}
+
+// resourceAuth0BruteForceProtectionDelete disables the protection, the settings themselves cannot be removed.
+func resourceAuth0BruteForceProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	_, err := auth0Client.UpdateBruteForceProtection(&BruteForceProtection{
+		Enabled:   false,
+		Shields:   readStringSetFromResource(d, "shields"),
+		Allowlist: readStringSetFromResource(d, "allowlist"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not disable auth0 brute-force protection: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package auth0
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0BruteForceProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testInvalidAllowlistBruteForceProtectionConfig,
+				ExpectError: regexp.MustCompile("expected allowlist.* to contain a valid"),
+			},
+			{
+				Config: testCreateBruteForceProtectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "allowlist.#", "2"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "mode", "count_per_identifier_and_ip"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "max_attempts", "10"),
+				),
+			},
+			{
+				Config: testUpdateBruteForceProtectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "shields.#", "1"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "allowlist.#", "0"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "mode", "count_per_identifier"),
+					resource.TestCheckResourceAttr("auth0_brute_force_protection.test_protection", "max_attempts", "5"),
+				),
+			},
+		},
+	})
+}
+
+const testInvalidAllowlistBruteForceProtectionConfig = `
+
+resource "auth0_brute_force_protection" "test_protection" {
+	enabled 	= true
+	allowlist 	= ["10.0.0.0/33"]
+}
+
+`
+
+const testCreateBruteForceProtectionConfig = `
+
+resource "auth0_brute_force_protection" "test_protection" {
+	enabled 		= true
+	shields 		= ["block", "user_notification"]
+	allowlist 		= ["10.0.0.0/8", "192.168.1.1"]
+	mode 			= "count_per_identifier_and_ip"
+	max_attempts 	= 10
+}
+
+`
+
+const testUpdateBruteForceProtectionConfig = `
+
+resource "auth0_brute_force_protection" "test_protection" {
+	enabled 		= true
+	shields 		= ["block"]
+	mode 			= "count_per_identifier"
+	max_attempts 	= 5
+}
+
+`
@@ -2,52 +2,201 @@ package auth0
 
 import (
 	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAuth0Client() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAuth0ClientCreate,
 		Read:   resourceAuth0ClientRead,
+		Update: resourceAuth0ClientUpdate,
 		Delete: resourceAuth0ClientDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAuth0ClientImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"app_type": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"grant_types": &schema.Schema{
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
-				ForceNew: true,
 			},
 			"token_endpoint_auth_method": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"client_metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     schema.TypeString,
 				Default:  nil,
-				ForceNew: true,
+			},
+			// Ids of the auth0_client_credential keys the client authenticates with using private_key_jwt.
+			"private_key_jwt_credential_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
 			},
 			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			// When false client_secret is left empty, so the secret never ends up in the state.
+			"store_client_secret": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// Local file the secret is written to (with 0600 permissions) when the client is created.
+			"client_secret_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"logo_uri": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"callbacks": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"allowed_origins": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"web_origins": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"allowed_logout_urls": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"initiate_login_uri": &schema.Schema{
 				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"oidc_conformant": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_first_party": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
+			"cross_origin_auth": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"custom_login_page_on": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"custom_login_page": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"jwt_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alg": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"HS256", "RS256"}, false),
+						},
+						"lifetime_in_seconds": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"scopes": &schema.Schema{
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+						},
+						"secret_encoded": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"refresh_token": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"rotating", "non-rotating"}, false),
+						},
+						"expiration_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"expiring", "non-expiring"}, false),
+						},
+						"leeway": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"token_lifetime": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"infinite_token_lifetime": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"idle_token_lifetime": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"infinite_idle_token_lifetime": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -66,6 +215,14 @@ func resourceAuth0ClientCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(client.ClientId)
 
+	if path := readStringFromResource(d, "client_secret_file"); path != "" {
+		err = writeClientSecretFile(path, client.ClientSecret)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceAuth0ClientRead(d, meta)
 }
 
@@ -85,9 +242,90 @@ func resourceAuth0ClientRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", client.Name)
 		d.Set("grant_types", client.GrantTypes)
 		d.Set("app_type", client.ApplicationType)
-		d.Set("token_endpoint_auth_method", client.TokenEndpointAuthMethod)
+		// Auth0 clears token_endpoint_auth_method while private_key_jwt credentials are enabled, keep the
+		// configured method rather than reporting a difference.
+		credentialIds := clientPrivateKeyJwtCredentialIds(client)
+		if client.TokenEndpointAuthMethod != "" || len(credentialIds) == 0 {
+			d.Set("token_endpoint_auth_method", client.TokenEndpointAuthMethod)
+		}
+		d.Set("private_key_jwt_credential_ids", credentialIds)
 		d.Set("client_metadata", client.ClientMetaData)
-		d.Set("client_secret", client.ClientSecret)
+		if d.Get("store_client_secret").(bool) {
+			d.Set("client_secret", client.ClientSecret)
+		} else {
+			d.Set("client_secret", "")
+		}
+
+		d.Set("description", client.Description)
+		d.Set("logo_uri", client.LogoUri)
+		d.Set("callbacks", client.Callbacks)
+		d.Set("allowed_origins", client.AllowedOrigins)
+		d.Set("web_origins", client.WebOrigins)
+		d.Set("allowed_logout_urls", client.AllowedLogoutUrls)
+		d.Set("initiate_login_uri", client.InitiateLoginUri)
+		d.Set("oidc_conformant", client.OidcConformant)
+		d.Set("is_first_party", client.IsFirstParty)
+		d.Set("cross_origin_auth", client.CrossOriginAuth)
+		d.Set("custom_login_page_on", client.CustomLoginPageOn)
+		d.Set("custom_login_page", client.CustomLoginPage)
+		d.Set("jwt_configuration", flattenClientJwtConfiguration(client.JwtConfiguration))
+		d.Set("refresh_token", flattenClientRefreshToken(client.RefreshToken))
+	}
+
+	return nil
+}
+
+func resourceAuth0ClientUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	clientRequest := createClientRequestFromResourceData(d)
+
+	for _, key := range []string{"description", "logo_uri", "initiate_login_uri"} {
+		if readStringFromResource(d, key) == "" && d.HasChange(key) {
+			clientRequest.NullFields = append(clientRequest.NullFields, key)
+		}
+	}
+
+	// Auth0 merges the client metadata on update, removed keys have to be explicitly set to null
+	if d.HasChange("client_metadata") {
+		clientRequest.ClientMetaData = readClientMetadataPatch(d)
+	}
+
+	// Auth0 rejects token_endpoint_auth_method while private_key_jwt credentials are enabled on the client.
+	if len(d.Get("private_key_jwt_credential_ids").([]interface{})) > 0 {
+		clientRequest.TokenEndpointAuthMethod = ""
+	}
+
+	client, err := auth0Client.UpdateClientById(d.Id(), clientRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 client: %v error: %v", clientRequest, err)
+	}
+
+	if path := readStringFromResource(d, "client_secret_file"); path != "" && d.HasChange("client_secret_file") {
+		err = writeClientSecretFile(path, client.ClientSecret)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAuth0ClientRead(d, meta)
+}
+
+func resourceAuth0ClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	d.Set("store_client_secret", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func writeClientSecretFile(path string, secret string) error {
+	err := os.WriteFile(path, []byte(secret), 0600)
+
+	if err != nil {
+		return fmt.Errorf("could not write auth0 client secret to %s: %v", path, err)
 	}
 
 	return nil
@@ -106,6 +344,22 @@ func resourceAuth0ClientDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func readClientMetadataPatch(d *schema.ResourceData) map[string]interface{} {
+
+	patch := map[string]interface{}{}
+
+	oldMap, newMap := d.GetChange("client_metadata")
+	for k := range oldMap.(map[string]interface{}) {
+		patch[k] = nil
+	}
+
+	for k, v := range newMap.(map[string]interface{}) {
+		patch[k] = v
+	}
+
+	return patch
+}
+
 func createClientRequestFromResourceData(d *schema.ResourceData) *ClientRequest {
 	clientRequest := &ClientRequest{}
 
@@ -114,6 +368,90 @@ func createClientRequestFromResourceData(d *schema.ResourceData) *ClientRequest
 	clientRequest.GrantTypes = readStringArrayFromResource(d, "grant_types")
 	clientRequest.TokenEndpointAuthMethod = readStringFromResource(d, "token_endpoint_auth_method")
 	clientRequest.ClientMetaData = readMapFromResource(d, "client_metadata")
+	clientRequest.Description = readStringFromResource(d, "description")
+	clientRequest.LogoUri = readStringFromResource(d, "logo_uri")
+	clientRequest.Callbacks = readStringArrayOrEmptyFromResource(d, "callbacks")
+	clientRequest.AllowedOrigins = readStringArrayOrEmptyFromResource(d, "allowed_origins")
+	clientRequest.WebOrigins = readStringArrayOrEmptyFromResource(d, "web_origins")
+	clientRequest.AllowedLogoutUrls = readStringArrayOrEmptyFromResource(d, "allowed_logout_urls")
+	clientRequest.InitiateLoginUri = readStringFromResource(d, "initiate_login_uri")
+	clientRequest.OidcConformant = readBoolPointerFromResource(d, "oidc_conformant")
+	clientRequest.IsFirstParty = readBoolPointerFromResource(d, "is_first_party")
+	clientRequest.CrossOriginAuth = readBoolPointerFromResource(d, "cross_origin_auth")
+	clientRequest.CustomLoginPageOn = readBoolPointerFromResource(d, "custom_login_page_on")
+	clientRequest.CustomLoginPage = readStringFromResource(d, "custom_login_page")
+
+	if jwtList := d.Get("jwt_configuration").([]interface{}); len(jwtList) > 0 && jwtList[0] != nil {
+		jwt := jwtList[0].(map[string]interface{})
+
+		clientRequest.JwtConfiguration = &ClientJwtConfiguration{
+			Alg:               jwt["alg"].(string),
+			LifetimeInSeconds: jwt["lifetime_in_seconds"].(int),
+			SecretEncoded:     jwt["secret_encoded"].(bool),
+			Scopes:            map[string]string{},
+		}
+
+		for key, value := range jwt["scopes"].(map[string]interface{}) {
+			clientRequest.JwtConfiguration.Scopes[key] = value.(string)
+		}
+	}
+
+	if refreshTokenList := d.Get("refresh_token").([]interface{}); len(refreshTokenList) > 0 && refreshTokenList[0] != nil {
+		refreshToken := refreshTokenList[0].(map[string]interface{})
+
+		clientRequest.RefreshToken = &ClientRefreshToken{
+			RotationType:              refreshToken["rotation_type"].(string),
+			ExpirationType:            refreshToken["expiration_type"].(string),
+			Leeway:                    refreshToken["leeway"].(int),
+			TokenLifetime:             refreshToken["token_lifetime"].(int),
+			InfiniteTokenLifetime:     refreshToken["infinite_token_lifetime"].(bool),
+			IdleTokenLifetime:         refreshToken["idle_token_lifetime"].(int),
+			InfiniteIdleTokenLifetime: refreshToken["infinite_idle_token_lifetime"].(bool),
+		}
+	}
 
 	return clientRequest
 }
+
+func flattenClientJwtConfiguration(jwt *ClientJwtConfiguration) []interface{} {
+	if jwt == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"alg":                 jwt.Alg,
+		"lifetime_in_seconds": jwt.LifetimeInSeconds,
+		"scopes":              jwt.Scopes,
+		"secret_encoded":      jwt.SecretEncoded,
+	}}
+}
+
+func flattenClientRefreshToken(refreshToken *ClientRefreshToken) []interface{} {
+	if refreshToken == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"rotation_type":                refreshToken.RotationType,
+		"expiration_type":              refreshToken.ExpirationType,
+		"leeway":                       refreshToken.Leeway,
+		"token_lifetime":               refreshToken.TokenLifetime,
+		"infinite_token_lifetime":      refreshToken.InfiniteTokenLifetime,
+		"idle_token_lifetime":          refreshToken.IdleTokenLifetime,
+		"infinite_idle_token_lifetime": refreshToken.InfiniteIdleTokenLifetime,
+	}}
+}
+
+func clientPrivateKeyJwtCredentialIds(client *Client) []string {
+	ids := []string{}
+
+	if client.ClientAuthenticationMethods == nil || client.ClientAuthenticationMethods.PrivateKeyJwt == nil {
+		return ids
+	}
+
+	for _, credential := range client.ClientAuthenticationMethods.PrivateKeyJwt.Credentials {
+		ids = append(ids, credential.Id)
+	}
+
+	return ids
+}
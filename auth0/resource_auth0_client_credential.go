@@ -0,0 +1,295 @@
+package auth0
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceAuth0ClientCredential registers a public key used by a client to authenticate with private_key_jwt.
+// Creating the credential also enables it on the client, so keys can be rotated with create_before_destroy.
+func resourceAuth0ClientCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0ClientCredentialCreate,
+		Read:   resourceAuth0ClientCredentialRead,
+		Update: resourceAuth0ClientCredentialUpdate,
+		Delete: resourceAuth0ClientCredentialDelete,
+
+		// Imported using "<client_id>:<credential_id>".
+		Importer: &schema.ResourceImporter{
+			State: resourceAuth0ClientCredentialImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"credential_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public_key",
+				ValidateFunc: validation.StringInSlice([]string{"public_key", "x509_cert"}, false),
+			},
+			// PEM encoded public key or certificate, never read back from Auth0.
+			"pem": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alg": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RS256", "RS384", "PS256"}, false),
+			},
+			"parse_expiry_from_cert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Restored on the client once its last private_key_jwt credential is removed. Defaults to the
+			// method the client used when the credential was created.
+			"fallback_token_endpoint_auth_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "client_secret_post", "client_secret_basic"}, false),
+			},
+			"expires_at": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0ClientCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	clientId := d.Get("client_id").(string)
+
+	unlock := lockClientCredentials(clientId)
+	defer unlock()
+
+	client, err := getClientForCredentials(auth0Client, clientId)
+
+	if err != nil {
+		return err
+	}
+
+	// While other credentials are enabled Auth0 no longer reports the client's own method.
+	fallback := readStringFromResource(d, "fallback_token_endpoint_auth_method")
+	if fallback == "" {
+		fallback = client.TokenEndpointAuthMethod
+	}
+
+	if fallback == "" {
+		return fmt.Errorf("could not determine the token endpoint auth method of client %s, set fallback_token_endpoint_auth_method", clientId)
+	}
+
+	credentialRequest := &ClientCredentialRequest{
+		CredentialType:      readStringFromResource(d, "credential_type"),
+		Name:                readStringFromResource(d, "name"),
+		Pem:                 readStringFromResource(d, "pem"),
+		Alg:                 readStringFromResource(d, "alg"),
+		ParseExpiryFromCert: readBoolFromResource(d, "parse_expiry_from_cert"),
+		ExpiresAt:           readStringFromResource(d, "expires_at"),
+	}
+
+	credential, err := auth0Client.CreateClientCredential(clientId, credentialRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 client credential for client %s: %v", clientId, err)
+	}
+
+	d.SetId(credential.Id)
+	d.Set("fallback_token_endpoint_auth_method", fallback)
+
+	err = updateClientPrivateKeyJwtCredentials(auth0Client, client, fallback, func(credentials []ClientCredentialReference) []ClientCredentialReference {
+		return append(credentials, ClientCredentialReference{Id: credential.Id})
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to enable auth0 client credential %s: %v", credential.Id, err)
+	}
+
+	return resourceAuth0ClientCredentialRead(d, meta)
+}
+
+func resourceAuth0ClientCredentialRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	credential, err := auth0Client.GetClientCredentialById(d.Get("client_id").(string), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 client credential: %v", err)
+	}
+
+	if credential == nil {
+		d.SetId("")
+	} else {
+		d.Set("name", credential.Name)
+		d.Set("credential_type", credential.CredentialType)
+		d.Set("alg", credential.Alg)
+		d.Set("expires_at", credential.ExpiresAt)
+		d.Set("key_id", credential.Kid)
+		d.Set("created_at", credential.CreatedAt)
+		d.Set("updated_at", credential.UpdatedAt)
+	}
+
+	return nil
+}
+
+func resourceAuth0ClientCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	// Only the expiry of a credential can be changed, fallback_token_endpoint_auth_method is only kept in state.
+	if d.HasChange("expires_at") {
+		credentialRequest := &ClientCredentialRequest{
+			ExpiresAt: readStringFromResource(d, "expires_at"),
+		}
+
+		_, err := auth0Client.UpdateClientCredentialById(d.Get("client_id").(string), d.Id(), credentialRequest)
+
+		if err != nil {
+			return fmt.Errorf("failed to update auth0 client credential %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceAuth0ClientCredentialRead(d, meta)
+}
+
+func resourceAuth0ClientCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	clientId := d.Get("client_id").(string)
+
+	unlock := lockClientCredentials(clientId)
+	defer unlock()
+
+	client, err := getClientForCredentials(auth0Client, clientId)
+
+	if err != nil {
+		return err
+	}
+
+	// A credential which is still in use by the client cannot be deleted.
+	err = updateClientPrivateKeyJwtCredentials(auth0Client, client, readStringFromResource(d, "fallback_token_endpoint_auth_method"), func(credentials []ClientCredentialReference) []ClientCredentialReference {
+		remaining := []ClientCredentialReference{}
+		for _, credential := range credentials {
+			if credential.Id != d.Id() {
+				remaining = append(remaining, credential)
+			}
+		}
+
+		return remaining
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not disable auth0 client credential %s: %v", d.Id(), err)
+	}
+
+	err = auth0Client.DeleteClientCredentialById(clientId, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 client credential: %v", err)
+	}
+
+	return nil
+}
+
+func resourceAuth0ClientCredentialImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid client credential id %q, expected <client_id>:<credential_id>", d.Id())
+	}
+
+	d.Set("client_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// clientCredentialsLocks holds a mutex per client id, the credentials enabled on a client are replaced as a
+// whole so concurrent creates and deletes of credentials for the same client must not interleave.
+var clientCredentialsLocks sync.Map
+
+func lockClientCredentials(clientId string) func() {
+	lock, _ := clientCredentialsLocks.LoadOrStore(clientId, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+
+	return mutex.Unlock
+}
+
+func getClientForCredentials(auth0Client *AuthClient, clientId string) (*Client, error) {
+	client, err := auth0Client.GetClientById(clientId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read auth0 client %s: %v", clientId, err)
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client %s not found", clientId)
+	}
+
+	return client, nil
+}
+
+// updateClientPrivateKeyJwtCredentials applies update to the private_key_jwt credentials currently enabled on
+// the client. The client falls back to fallbackAuthMethod once no credential is left. Callers must hold the
+// lock returned by lockClientCredentials for the client.
+func updateClientPrivateKeyJwtCredentials(auth0Client *AuthClient, client *Client, fallbackAuthMethod string, update func([]ClientCredentialReference) []ClientCredentialReference) error {
+	credentials := []ClientCredentialReference{}
+	if client.ClientAuthenticationMethods != nil && client.ClientAuthenticationMethods.PrivateKeyJwt != nil {
+		credentials = client.ClientAuthenticationMethods.PrivateKeyJwt.Credentials
+	}
+
+	credentials = update(credentials)
+
+	request := &ClientAuthenticationMethodsRequest{}
+	if len(credentials) > 0 {
+		request.ClientAuthenticationMethods = &ClientAuthenticationMethods{
+			PrivateKeyJwt: &PrivateKeyJwtAuthenticationMethod{Credentials: credentials},
+		}
+	} else if fallbackAuthMethod != "" {
+		request.TokenEndpointAuthMethod = &fallbackAuthMethod
+	} else {
+		return fmt.Errorf("no token endpoint auth method to restore on client %s, set fallback_token_endpoint_auth_method", client.ClientId)
+	}
+
+	_, err := auth0Client.UpdateClientAuthenticationMethods(client.ClientId, request)
+
+	return err
+}
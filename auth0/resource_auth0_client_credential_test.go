@@ -0,0 +1,218 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0ClientCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateClientCredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientCredentialExists("auth0_client_credential.test_credential"),
+					resource.TestCheckResourceAttrPair("auth0_client_credential.test_credential", "client_id", "auth0_client.test_client", "id"),
+					resource.TestCheckResourceAttr("auth0_client_credential.test_credential", "credential_type", "public_key"),
+					resource.TestCheckResourceAttr("auth0_client_credential.test_credential", "alg", "RS256"),
+					resource.TestCheckResourceAttr("auth0_client_credential.test_credential", "expires_at", "2030-01-01T00:00:00.000Z"),
+					resource.TestCheckResourceAttrSet("auth0_client_credential.test_credential", "key_id"),
+				),
+			},
+			{
+				Config: testUpdateClientCredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientCredentialExists("auth0_client_credential.test_credential"),
+					resource.TestCheckResourceAttr("auth0_client_credential.test_credential", "expires_at", "2031-01-01T00:00:00.000Z"),
+				),
+			},
+			{
+				Config: testUpdateClientWithCredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientCredentialExists("auth0_client_credential.test_credential"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "description", "updated with credential"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "token_endpoint_auth_method", "client_secret_post"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "private_key_jwt_credential_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0ClientCredentialDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	for _, rs := range getResourcesByType("auth0_client_credential", state) {
+		credential, err := client.GetClientCredentialById(rs.Primary.Attributes["client_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error calling get auth0 client credential by id: %v", err)
+		}
+
+		if credential != nil {
+			return fmt.Errorf("client credential %s still exists, %+v", rs.Primary.ID, credential)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0ClientCredentialExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		auth0Client, err := client.GetClientById(rs.Primary.Attributes["client_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if auth0Client == nil {
+			return fmt.Errorf("client with id %v not found", rs.Primary.Attributes["client_id"])
+		}
+
+		for _, id := range clientPrivateKeyJwtCredentialIds(auth0Client) {
+			if id == rs.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("client credential %s is not enabled on client %s", rs.Primary.ID, auth0Client.ClientId)
+	}
+}
+
+const testClientCredentialPublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAiCaWFH6VYvjiXtLBoqV9
+h7bq4Y7tGET0Wf8E2+nNptx6H2Zb9p63zvKSe89wM+Cjr5zMLeSFycuoW7FPVxap
+pL0wQwW0kBi+KzU7DB/2VJdAxdr8q4wpvBgCbHR/NUo6+iTa4nh3rAzRmGMc4JMR
+kAF/mpV+nbtznjOKDkq8ZIm/6yAG3weIC4BQ526dyG5Sgn7fdWUMEzOhbyVyLMcd
+pZgtK2XRJJHfay2A8fxmV6NWVF0gmg/iRHCUBtQ1yYCcqeAJTR6JIgx26THEmcgB
+nOGswrUYWyg47C6hCOw5EIrMo8DmOB0UGJsuVlMqqipk58nWiHT9qWW3LVhSjU75
+lwIDAQAB
+-----END PUBLIC KEY-----
+`
+
+const testCreateClientCredentialConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client credential"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+}
+
+resource "auth0_client_credential" "test_credential" {
+	client_id 	= auth0_client.test_client.id
+	name 		= "test credential"
+	alg 		= "RS256"
+	expires_at 	= "2030-01-01T00:00:00.000Z"
+	pem 		= <<EOT
+` + testClientCredentialPublicKey + `EOT
+}
+
+`
+
+const testUpdateClientCredentialConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client credential"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+}
+
+resource "auth0_client_credential" "test_credential" {
+	client_id 	= auth0_client.test_client.id
+	name 		= "test credential"
+	alg 		= "RS256"
+	expires_at 	= "2031-01-01T00:00:00.000Z"
+	pem 		= <<EOT
+` + testClientCredentialPublicKey + `EOT
+}
+
+`
+
+const testUpdateClientWithCredentialConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client credential"
+	description					= "updated with credential"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+}
+
+resource "auth0_client_credential" "test_credential" {
+	client_id 	= auth0_client.test_client.id
+	name 		= "test credential"
+	alg 		= "RS256"
+	expires_at 	= "2031-01-01T00:00:00.000Z"
+	pem 		= <<EOT
+` + testClientCredentialPublicKey + `EOT
+}
+
+`
+
+func TestAccAuth0ClientCredentialRestoresAuthMethod(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateClientSecretBasicCredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientCredentialExists("auth0_client_credential.test_credential"),
+					resource.TestCheckResourceAttr("auth0_client_credential.test_credential", "fallback_token_endpoint_auth_method", "client_secret_basic"),
+				),
+			},
+			{
+				Config: testRemoveClientSecretBasicCredentialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client.test_client", "token_endpoint_auth_method", "client_secret_basic"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "private_key_jwt_credential_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testRemoveClientSecretBasicCredentialConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client credential basic"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_basic"
+}
+
+`
+
+const testCreateClientSecretBasicCredentialConfig = testRemoveClientSecretBasicCredentialConfig + `
+
+resource "auth0_client_credential" "test_credential" {
+	client_id 	= auth0_client.test_client.id
+	name 		= "test credential"
+	pem 		= <<EOT
+` + testClientCredentialPublicKey + `EOT
+}
+
+`
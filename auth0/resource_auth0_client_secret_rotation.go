@@ -0,0 +1,128 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAuth0ClientSecretRotation rotates the secret of a client whenever it is created, changing any of the
+// keepers replaces the resource and therefore rotates the secret again.
+func resourceAuth0ClientSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0ClientSecretRotationCreate,
+		Read:   resourceAuth0ClientSecretRotationRead,
+		Update: resourceAuth0ClientSecretRotationUpdate,
+		Delete: resourceAuth0ClientSecretRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"client_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Arbitrary values, e.g. a time_rotating timestamp, which trigger a rotation when they change.
+			"keepers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				ForceNew: true,
+			},
+			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"store_client_secret": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// Local file the secret is written to (with 0600 permissions) every time it is rotated.
+			"client_secret_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0ClientSecretRotationCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	clientId := d.Get("client_id").(string)
+
+	client, err := auth0Client.RotateClientSecret(clientId)
+
+	if err != nil {
+		return fmt.Errorf("failed to rotate secret of auth0 client %s: %v", clientId, err)
+	}
+
+	d.SetId(clientId)
+
+	if path := readStringFromResource(d, "client_secret_file"); path != "" {
+		err = writeClientSecretFile(path, client.ClientSecret)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAuth0ClientSecretRotationRead(d, meta)
+}
+
+func resourceAuth0ClientSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	client, err := auth0Client.GetClientById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 client: %v", err)
+	}
+
+	if client == nil {
+		d.SetId("")
+	} else if d.Get("store_client_secret").(bool) {
+		d.Set("client_secret", client.ClientSecret)
+	} else {
+		d.Set("client_secret", "")
+	}
+
+	return nil
+}
+
+// resourceAuth0ClientSecretRotationUpdate only changes where the current secret is kept, it doesn't rotate it.
+func resourceAuth0ClientSecretRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	if path := readStringFromResource(d, "client_secret_file"); path != "" && d.HasChange("client_secret_file") {
+		client, err := auth0Client.GetClientById(d.Id())
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 client: %v", err)
+		}
+
+		if client == nil {
+			return fmt.Errorf("client %s not found", d.Id())
+		}
+
+		err = writeClientSecretFile(path, client.ClientSecret)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAuth0ClientSecretRotationRead(d, meta)
+}
+
+// resourceAuth0ClientSecretRotationDelete leaves the current secret in place.
+func resourceAuth0ClientSecretRotationDelete(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId("")
+
+	return nil
+}
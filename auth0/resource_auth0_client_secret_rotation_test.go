@@ -0,0 +1,147 @@
+package auth0
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0ClientSecretRotation(t *testing.T) {
+	var firstSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateClientSecretRotationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientExists("auth0_client.test_client"),
+					resource.TestCheckResourceAttrPair("auth0_client_secret_rotation.test_rotation", "client_id", "auth0_client.test_client", "id"),
+					resource.TestCheckResourceAttrSet("auth0_client_secret_rotation.test_rotation", "client_secret"),
+					testAccStoreClientSecret("auth0_client_secret_rotation.test_rotation", &firstSecret),
+				),
+			},
+			{
+				Config: testUpdateClientSecretRotationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_client_secret_rotation.test_rotation", "client_id", "auth0_client.test_client", "id"),
+					testAccCheckClientSecretRotated("auth0_client_secret_rotation.test_rotation", &firstSecret),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuth0ClientSecretRotationNotStored(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "client_secret")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testSecretNotStoredClientSecretRotationConfig, secretFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client_secret_rotation.test_rotation", "store_client_secret", "false"),
+					resource.TestCheckResourceAttr("auth0_client_secret_rotation.test_rotation", "client_secret", ""),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "client_secret", ""),
+					testAccCheckClientSecretFileWritten(secretFile),
+				),
+			},
+		},
+	})
+}
+
+func testAccStoreClientSecret(resourceKey string, secret *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		*secret = rs.Primary.Attributes["client_secret"]
+
+		return nil
+	}
+}
+
+func testAccCheckClientSecretRotated(resourceKey string, previousSecret *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.Attributes["client_secret"] == *previousSecret {
+			return fmt.Errorf("client secret of %s was not rotated", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateClientSecretRotationConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client secret rotation"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+}
+
+resource "auth0_client_secret_rotation" "test_rotation" {
+	client_id = auth0_client.test_client.id
+
+	keepers = {
+		rotated_at = "2022-01-01"
+	}
+}
+
+`
+
+const testUpdateClientSecretRotationConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client secret rotation"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+}
+
+resource "auth0_client_secret_rotation" "test_rotation" {
+	client_id = auth0_client.test_client.id
+
+	keepers = {
+		rotated_at = "2022-02-01"
+	}
+}
+
+`
+
+const testSecretNotStoredClientSecretRotationConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client secret rotation not stored"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+	store_client_secret 		= false
+}
+
+resource "auth0_client_secret_rotation" "test_rotation" {
+	client_id 			= auth0_client.test_client.id
+	store_client_secret = false
+	client_secret_file 	= "%s"
+}
+
+`
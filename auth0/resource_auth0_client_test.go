@@ -2,12 +2,17 @@ package auth0
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"testing"
 )
 
 func TestAccAuth0Client(t *testing.T) {
+	var clientId string
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -23,6 +28,12 @@ func TestAccAuth0Client(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_client.test_client", "token_endpoint_auth_method", "none"),
 					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.item1", "value1"),
 					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.item2", "value2"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "description", "test client description"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "callbacks.0", "https://example.com/callback"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "allowed_logout_urls.0", "https://example.com/logout"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "oidc_conformant", "true"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "jwt_configuration.0.alg", "RS256"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "jwt_configuration.0.lifetime_in_seconds", "3600"),
 				),
 			},
 			{
@@ -35,12 +46,70 @@ func TestAccAuth0Client(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_client.test_client", "token_endpoint_auth_method", "none"),
 					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.item1", "value3"),
 					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.item2", "value4"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "description", "updated test client description"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "callbacks.#", "2"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "callbacks.1", "https://example.com/callback2"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "allowed_logout_urls.#", "0"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "web_origins.0", "https://example.com"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "cross_origin_auth", "true"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "jwt_configuration.0.lifetime_in_seconds", "7200"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "refresh_token.0.rotation_type", "rotating"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "refresh_token.0.expiration_type", "expiring"),
+					testAccStoreClientId("auth0_client.test_client", &clientId),
+				),
+			},
+			{
+				Config: testUpdateClientInPlaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientExists("auth0_client.test_client"),
+					testAccCheckClientNotRecreated("auth0_client.test_client", &clientId),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "name", "renamed test client"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "app_type", "regular_web"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "grant_types.#", "2"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "token_endpoint_auth_method", "client_secret_post"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.%", "1"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "client_metadata.item1", "value3"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "description", ""),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "refresh_token.0.leeway", "0"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "refresh_token.0.infinite_token_lifetime", "false"),
 				),
 			},
 		},
 	})
 }
 
+func testAccStoreClientId(resourceKey string, clientId *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		*clientId = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testAccCheckClientNotRecreated(resourceKey string, previousId *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID != *previousId {
+			return fmt.Errorf("client %s was recreated as %s", *previousId, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func TestAccAuth0ClientImport(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -59,6 +128,44 @@ func TestAccAuth0ClientImport(t *testing.T) {
 	})
 }
 
+func TestAccAuth0ClientSecretNotStored(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "client_secret")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0ClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testSecretNotStoredClientConfig, secretFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0ClientExists("auth0_client.test_client"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "store_client_secret", "false"),
+					resource.TestCheckResourceAttr("auth0_client.test_client", "client_secret", ""),
+					testAccCheckClientSecretFileWritten(secretFile),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClientSecretFileWritten(path string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		secret, err := os.ReadFile(path)
+
+		if err != nil {
+			return fmt.Errorf("could not read client secret file: %v", err)
+		}
+
+		if len(secret) == 0 {
+			return fmt.Errorf("client secret file %s is empty", path)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAuth0ClientDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*AuthClient)
@@ -121,6 +228,15 @@ resource "auth0_client" "test_client" {
 		item1 = "value1"
 		item2 = "value2"
 	}
+	description 				= "test client description"
+	callbacks 					= ["https://example.com/callback"]
+	allowed_logout_urls 		= ["https://example.com/logout"]
+	oidc_conformant 			= true
+
+	jwt_configuration {
+		alg 				= "RS256"
+		lifetime_in_seconds = 3600
+	}
 }
 `
 
@@ -135,6 +251,52 @@ resource "auth0_client" "test_client" {
 		item1 = "value3"
 		item2 = "value4"
 	}
+	description 				= "updated test client description"
+	callbacks 					= ["https://example.com/callback", "https://example.com/callback2"]
+	web_origins 				= ["https://example.com"]
+	oidc_conformant 			= true
+	cross_origin_auth 			= true
+
+	jwt_configuration {
+		alg 				= "RS256"
+		lifetime_in_seconds = 7200
+	}
+
+	refresh_token {
+		rotation_type 	= "rotating"
+		expiration_type = "expiring"
+		leeway 			= 0
+		token_lifetime 	= 2592000
+	}
+}
+
+`
+
+const testUpdateClientInPlaceConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "renamed test client"
+	app_type 					= "regular_web"
+	grant_types 				= ["authorization_code", "refresh_token"]
+	token_endpoint_auth_method 	= "client_secret_post"
+    client_metadata				= {
+		item1 = "value3"
+	}
+	callbacks 					= ["https://example.com/callback", "https://example.com/callback2"]
+	web_origins 				= ["https://example.com"]
+	oidc_conformant 			= true
+	cross_origin_auth 			= true
+
+	jwt_configuration {
+		alg 				= "RS256"
+		lifetime_in_seconds = 7200
+	}
+
+	refresh_token {
+		rotation_type 	= "rotating"
+		expiration_type = "expiring"
+		token_lifetime 	= 2592000
+	}
 }
 
 `
@@ -153,3 +315,16 @@ resource "auth0_client" "test_client" {
 }
 
 `
+
+const testSecretNotStoredClientConfig = `
+
+resource "auth0_client" "test_client" {
+	name						= "test client secret not stored"
+	app_type 					= "non_interactive"
+	grant_types 				= ["client_credentials"]
+	token_endpoint_auth_method 	= "client_secret_post"
+	store_client_secret 		= false
+	client_secret_file 			= "%s"
+}
+
+`
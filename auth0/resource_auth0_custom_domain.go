@@ -0,0 +1,187 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0CustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0CustomDomainCreate,
+		Read:   resourceAuth0CustomDomainRead,
+		Update: resourceAuth0CustomDomainUpdate,
+		Delete: resourceAuth0CustomDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auth0_managed_certs", "self_managed_certs"}, false),
+			},
+			"custom_client_ip_header": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true-client-ip", "cf-connecting-ip", "x-forwarded-for", "x-azure-clientip", ""}, false),
+			},
+			"tls_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"recommended", "compatible"}, false),
+			},
+			"primary": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The DNS records that have to be provisioned before the domain can be verified.
+			"verification": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0CustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	customDomainRequest := createCustomDomainRequestFromResourceData(d)
+
+	customDomain, err := auth0Client.CreateCustomDomain(customDomainRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 custom domain: %v error: %v", customDomainRequest, err)
+	}
+
+	d.SetId(customDomain.CustomDomainId)
+
+	return resourceAuth0CustomDomainRead(d, meta)
+}
+
+func resourceAuth0CustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	customDomain, err := auth0Client.GetCustomDomainById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 custom domain: %v", err)
+	}
+
+	if customDomain == nil {
+		d.SetId("")
+	} else {
+		d.Set("domain", customDomain.Domain)
+		d.Set("type", customDomain.Type)
+		d.Set("custom_client_ip_header", customDomain.CustomClientIpHeader)
+		d.Set("tls_policy", customDomain.TlsPolicy)
+		d.Set("primary", customDomain.Primary)
+		d.Set("status", customDomain.Status)
+		d.Set("origin_domain_name", customDomain.OriginDomainName)
+		d.Set("verification", flattenCustomDomainVerification(customDomain.Verification))
+	}
+
+	return nil
+}
+
+func resourceAuth0CustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	// Only the header and the TLS policy of a custom domain can be patched.
+	customDomainRequest := &CustomDomainRequest{
+		CustomClientIpHeader: readStringFromResource(d, "custom_client_ip_header"),
+		TlsPolicy:            readStringFromResource(d, "tls_policy"),
+	}
+
+	if customDomainRequest.CustomClientIpHeader == "" && d.HasChange("custom_client_ip_header") {
+		customDomainRequest.NullFields = append(customDomainRequest.NullFields, "custom_client_ip_header")
+	}
+
+	_, err := auth0Client.UpdateCustomDomainById(d.Id(), customDomainRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 custom domain: %v error: %v", customDomainRequest, err)
+	}
+
+	return resourceAuth0CustomDomainRead(d, meta)
+}
+
+func resourceAuth0CustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.DeleteCustomDomainById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 custom domain: %v", err)
+	}
+
+	return nil
+}
+
+func createCustomDomainRequestFromResourceData(d *schema.ResourceData) *CustomDomainRequest {
+	customDomainRequest := &CustomDomainRequest{}
+
+	customDomainRequest.Domain = readStringFromResource(d, "domain")
+	customDomainRequest.Type = readStringFromResource(d, "type")
+	customDomainRequest.CustomClientIpHeader = readStringFromResource(d, "custom_client_ip_header")
+	customDomainRequest.TlsPolicy = readStringFromResource(d, "tls_policy")
+
+	return customDomainRequest
+}
+
+func flattenCustomDomainVerification(verification *CustomDomainVerification) []interface{} {
+	methods := make([]interface{}, 0)
+
+	if verification == nil {
+		return methods
+	}
+
+	for _, method := range verification.Methods {
+		methods = append(methods, map[string]interface{}{
+			"name":   method.Name,
+			"record": method.Record,
+			"domain": method.Domain,
+		})
+	}
+
+	return methods
+}
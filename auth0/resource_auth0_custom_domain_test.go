@@ -0,0 +1,134 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0CustomDomain(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0CustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateCustomDomainConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0CustomDomainExists("auth0_custom_domain.test_custom_domain"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "domain", "login.auth0-provider-test.com"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "type", "auth0_managed_certs"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "status", "pending_verification"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "verification.0.name", "CNAME"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "verification.0.domain", "login.auth0-provider-test.com"),
+				),
+			},
+			{
+				Config: testUpdateCustomDomainConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0CustomDomainExists("auth0_custom_domain.test_custom_domain"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "domain", "login.auth0-provider-test.com"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "tls_policy", "recommended"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "custom_client_ip_header", "cf-connecting-ip"),
+				),
+			},
+			{
+				Config: testRemoveHeaderCustomDomainConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0CustomDomainExists("auth0_custom_domain.test_custom_domain"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "tls_policy", "recommended"),
+					resource.TestCheckResourceAttr("auth0_custom_domain.test_custom_domain", "custom_client_ip_header", ""),
+				),
+			},
+			{
+				ResourceName:      "auth0_custom_domain.test_custom_domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0CustomDomainDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	customDomains := getResourcesByType("auth0_custom_domain", state)
+
+	if len(customDomains) != 1 {
+		return fmt.Errorf("expecting only 1 auth0 custom domain resource found %v", len(customDomains))
+	}
+
+	response, err := client.GetCustomDomainById(customDomains[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 custom domain by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("custom domain %s still exists, %+v", customDomains[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0CustomDomainExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		customDomain, err := client.GetCustomDomainById(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if customDomain == nil {
+			return fmt.Errorf("custom domain with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateCustomDomainConfig = `
+
+resource "auth0_custom_domain" "test_custom_domain" {
+	domain 	= "login.auth0-provider-test.com"
+	type 	= "auth0_managed_certs"
+}
+
+`
+
+const testUpdateCustomDomainConfig = `
+
+resource "auth0_custom_domain" "test_custom_domain" {
+	domain 					= "login.auth0-provider-test.com"
+	type 					= "auth0_managed_certs"
+	tls_policy 				= "recommended"
+	custom_client_ip_header = "cf-connecting-ip"
+}
+
+`
+
+const testRemoveHeaderCustomDomainConfig = `
+
+resource "auth0_custom_domain" "test_custom_domain" {
+	domain 		= "login.auth0-provider-test.com"
+	type 		= "auth0_managed_certs"
+	tls_policy 	= "recommended"
+}
+
+`
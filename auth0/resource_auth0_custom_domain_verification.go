@@ -0,0 +1,112 @@
+package auth0
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const customDomainStatusReady = "ready"
+
+// resourceAuth0CustomDomainVerification verifies a custom domain once its DNS records are in place, this allows
+// the records to be created from the verification attribute of auth0_custom_domain within the same apply.
+func resourceAuth0CustomDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0CustomDomainVerificationCreate,
+		Read:   resourceAuth0CustomDomainVerificationRead,
+		Delete: resourceAuth0CustomDomainVerificationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"origin_domain_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Only returned for self managed certificates, when the reverse proxy has to send it to Auth0.
+			"cname_api_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0CustomDomainVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	customDomainId := d.Get("custom_domain_id").(string)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		customDomain, err := auth0Client.VerifyCustomDomainById(customDomainId)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if customDomain.Status != customDomainStatusReady {
+			return resource.RetryableError(fmt.Errorf("custom domain %s is not verified yet, status: %s", customDomainId, customDomain.Status))
+		}
+
+		d.Set("cname_api_key", customDomain.CnameApiKey)
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to verify auth0 custom domain: %v", err)
+	}
+
+	d.SetId(customDomainId)
+
+	return resourceAuth0CustomDomainVerificationRead(d, meta)
+}
+
+func resourceAuth0CustomDomainVerificationRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	customDomain, err := auth0Client.GetCustomDomainById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 custom domain: %v", err)
+	}
+
+	// A domain which is no longer verified needs to go through verification again.
+	if customDomain == nil || customDomain.Status != customDomainStatusReady {
+		d.SetId("")
+	} else {
+		d.Set("custom_domain_id", customDomain.CustomDomainId)
+		d.Set("status", customDomain.Status)
+		d.Set("origin_domain_name", customDomain.OriginDomainName)
+	}
+
+	return nil
+}
+
+// resourceAuth0CustomDomainVerificationDelete only forgets the verification, a verified domain cannot be unverified.
+func resourceAuth0CustomDomainVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId("")
+
+	return nil
+}
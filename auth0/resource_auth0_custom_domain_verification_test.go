@@ -0,0 +1,61 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccAuth0CustomDomainVerification needs a domain whose CNAME record already points to the tenant.
+func TestAccAuth0CustomDomainVerification(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckCustomDomain(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0CustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCustomDomainVerificationConfig, os.Getenv("AUTH0_CUSTOM_DOMAIN")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0CustomDomainExists("auth0_custom_domain.test_custom_domain"),
+					resource.TestCheckResourceAttrPair("auth0_custom_domain_verification.test_verification", "custom_domain_id", "auth0_custom_domain.test_custom_domain", "id"),
+					resource.TestCheckResourceAttr("auth0_custom_domain_verification.test_verification", "status", customDomainStatusReady),
+					resource.TestCheckResourceAttrSet("auth0_custom_domain_verification.test_verification", "origin_domain_name"),
+				),
+			},
+			{
+				ResourceName:            "auth0_custom_domain_verification.test_verification",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cname_api_key"},
+			},
+		},
+	})
+}
+
+func testAccPreCheckCustomDomain(t *testing.T) {
+	if v := os.Getenv("AUTH0_CUSTOM_DOMAIN"); v == "" {
+		t.Fatal("AUTH0_CUSTOM_DOMAIN must be set for custom domain verification acceptance tests")
+	}
+}
+
+const testCustomDomainVerificationConfig = `
+
+resource "auth0_custom_domain" "test_custom_domain" {
+	domain 	= "%s"
+	type 	= "auth0_managed_certs"
+}
+
+resource "auth0_custom_domain_verification" "test_verification" {
+	custom_domain_id = auth0_custom_domain.test_custom_domain.id
+
+	timeouts {
+		create = "15m"
+	}
+}
+
+`
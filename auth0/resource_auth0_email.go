@@ -0,0 +1,185 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0Email() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0EmailCreate,
+		Read:   resourceAuth0EmailRead,
+		Update: resourceAuth0EmailUpdate,
+		Delete: resourceAuth0EmailDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mandrill", "ses", "sendgrid", "sparkpost", "mailgun", "smtp", "azure_cs", "ms365",
+				}, false),
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"default_from_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Auth0 never returns the secret parts of the credentials, so they are not read back.
+			"credentials": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"access_key_id": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"secret_access_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"region": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smtp_host": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smtp_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"smtp_user": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smtp_pass": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0EmailCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailProvider := createEmailProviderFromResourceData(d)
+
+	_, err := auth0Client.CreateEmailProvider(emailProvider)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 email provider: %s error: %v", emailProvider.Name, err)
+	}
+
+	d.SetId(emailProvider.Name)
+
+	return resourceAuth0EmailRead(d, meta)
+}
+
+func resourceAuth0EmailRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailProvider, err := auth0Client.GetEmailProvider()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 email provider: %v", err)
+	}
+
+	if emailProvider == nil {
+		d.SetId("")
+	} else {
+		d.Set("name", emailProvider.Name)
+		d.Set("enabled", emailProvider.Enabled != nil && *emailProvider.Enabled)
+		d.Set("default_from_address", emailProvider.DefaultFromAddress)
+	}
+
+	return nil
+}
+
+func resourceAuth0EmailUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailProvider := createEmailProviderFromResourceData(d)
+
+	_, err := auth0Client.UpdateEmailProvider(emailProvider)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 email provider: %s error: %v", emailProvider.Name, err)
+	}
+
+	d.SetId(emailProvider.Name)
+
+	return resourceAuth0EmailRead(d, meta)
+}
+
+func resourceAuth0EmailDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.DeleteEmailProvider()
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 email provider: %v", err)
+	}
+
+	return nil
+}
+
+func createEmailProviderFromResourceData(d *schema.ResourceData) *EmailProvider {
+	enabled := d.Get("enabled").(bool)
+
+	emailProvider := &EmailProvider{
+		Name:               readStringFromResource(d, "name"),
+		Enabled:            &enabled,
+		DefaultFromAddress: readStringFromResource(d, "default_from_address"),
+		Credentials:        &EmailProviderCredentials{},
+	}
+
+	if credentialsList := d.Get("credentials").([]interface{}); len(credentialsList) > 0 && credentialsList[0] != nil {
+		credentials := credentialsList[0].(map[string]interface{})
+
+		emailProvider.Credentials.ApiKey = credentials["api_key"].(string)
+		emailProvider.Credentials.AccessKeyId = credentials["access_key_id"].(string)
+		emailProvider.Credentials.SecretAccessKey = credentials["secret_access_key"].(string)
+		emailProvider.Credentials.Region = credentials["region"].(string)
+		emailProvider.Credentials.Domain = credentials["domain"].(string)
+		emailProvider.Credentials.SmtpHost = credentials["smtp_host"].(string)
+		emailProvider.Credentials.SmtpPort = credentials["smtp_port"].(int)
+		emailProvider.Credentials.SmtpUser = credentials["smtp_user"].(string)
+		emailProvider.Credentials.SmtpPass = credentials["smtp_pass"].(string)
+	}
+
+	return emailProvider
+}
@@ -0,0 +1,181 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0EmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0EmailTemplateCreate,
+		Read:   resourceAuth0EmailTemplateRead,
+		Update: resourceAuth0EmailTemplateUpdate,
+		Delete: resourceAuth0EmailTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"verify_email",
+					"verify_email_by_code",
+					"reset_email",
+					"welcome_email",
+					"blocked_account",
+					"stolen_credentials",
+					"enrollment_email",
+					"mfa_oob_code",
+					"user_invitation",
+					"change_password",
+					"password_reset",
+				}, false),
+			},
+			"body": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"syntax": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "liquid",
+			},
+			"result_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_lifetime_in_seconds": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAuth0EmailTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailTemplate := createEmailTemplateFromResourceData(d)
+
+	// Templates cannot be deleted, so one might already exist from a previous configuration.
+	existingTemplate, err := auth0Client.GetEmailTemplate(emailTemplate.Template)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 email template: %v", err)
+	}
+
+	if existingTemplate == nil {
+		_, err = auth0Client.CreateEmailTemplate(emailTemplate)
+	} else {
+		_, err = auth0Client.UpdateEmailTemplate(emailTemplate.Template, emailTemplate)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 email template: %s error: %v", emailTemplate.Template, err)
+	}
+
+	d.SetId(emailTemplate.Template)
+
+	return resourceAuth0EmailTemplateRead(d, meta)
+}
+
+func resourceAuth0EmailTemplateRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailTemplate, err := auth0Client.GetEmailTemplate(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 email template: %v", err)
+	}
+
+	if emailTemplate == nil {
+		d.SetId("")
+	} else {
+		d.Set("template", emailTemplate.Template)
+		d.Set("body", emailTemplate.Body)
+		d.Set("from", emailTemplate.From)
+		d.Set("subject", emailTemplate.Subject)
+		d.Set("syntax", emailTemplate.Syntax)
+		d.Set("result_url", emailTemplate.ResultUrl)
+		d.Set("url_lifetime_in_seconds", emailTemplate.UrlLifetimeInSeconds)
+		d.Set("enabled", emailTemplate.Enabled != nil && *emailTemplate.Enabled)
+	}
+
+	return nil
+}
+
+func resourceAuth0EmailTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	emailTemplate := createEmailTemplateFromResourceData(d)
+
+	if emailTemplate.ResultUrl == "" && d.HasChange("result_url") {
+		emailTemplate.NullFields = append(emailTemplate.NullFields, "resultUrl")
+	}
+
+	if emailTemplate.UrlLifetimeInSeconds == 0 && d.HasChange("url_lifetime_in_seconds") {
+		emailTemplate.NullFields = append(emailTemplate.NullFields, "urlLifetimeInSeconds")
+	}
+
+	_, err := auth0Client.UpdateEmailTemplate(d.Id(), emailTemplate)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 email template: %s error: %v", d.Id(), err)
+	}
+
+	return resourceAuth0EmailTemplateRead(d, meta)
+}
+
+// resourceAuth0EmailTemplateDelete disables the template, as Auth0 does not allow templates to be deleted.
+func resourceAuth0EmailTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	enabled := false
+	_, err := auth0Client.UpdateEmailTemplate(d.Id(), &EmailTemplate{Enabled: &enabled})
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 email template: %v", err)
+	}
+
+	return nil
+}
+
+func createEmailTemplateFromResourceData(d *schema.ResourceData) *EmailTemplate {
+	enabled := d.Get("enabled").(bool)
+
+	emailTemplate := &EmailTemplate{}
+
+	emailTemplate.Template = readStringFromResource(d, "template")
+	emailTemplate.Body = readStringFromResource(d, "body")
+	emailTemplate.From = readStringFromResource(d, "from")
+	emailTemplate.Subject = readStringFromResource(d, "subject")
+	emailTemplate.Syntax = readStringFromResource(d, "syntax")
+	emailTemplate.ResultUrl = readStringFromResource(d, "result_url")
+	emailTemplate.UrlLifetimeInSeconds = d.Get("url_lifetime_in_seconds").(int)
+	emailTemplate.Enabled = &enabled
+
+	return emailTemplate
+}
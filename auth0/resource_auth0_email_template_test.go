@@ -0,0 +1,178 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0EmailTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0EmailTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateEmailTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0EmailTemplateExists("auth0_email_template.test_template"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "template", "welcome_email"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "body", "<html><body><h1>Welcome!</h1></body></html>"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "from", "welcome@example.com"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "subject", "Welcome"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "syntax", "liquid"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "url_lifetime_in_seconds", "3600"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "enabled", "true"),
+				),
+			},
+			{
+				Config: testUpdateEmailTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0EmailTemplateExists("auth0_email_template.test_template"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "body", "<html><body><h1>Hello!</h1></body></html>"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "subject", "Hello"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "result_url", "https://example.com/welcome"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "enabled", "false"),
+				),
+			},
+			{
+				Config: testClearEmailTemplateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0EmailTemplateExists("auth0_email_template.test_template"),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "result_url", ""),
+					resource.TestCheckResourceAttr("auth0_email_template.test_template", "url_lifetime_in_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:      "auth0_email_template.test_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0EmailTemplateDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	templates := getResourcesByType("auth0_email_template", state)
+
+	if len(templates) != 1 {
+		return fmt.Errorf("expecting only 1 auth0 email template resource found %v", len(templates))
+	}
+
+	response, err := client.GetEmailTemplate(templates[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 email template: %v", err)
+	}
+
+	if response != nil && response.Enabled != nil && *response.Enabled {
+		return fmt.Errorf("email template %s is still enabled, %+v", templates[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0EmailTemplateExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		emailTemplate, err := client.GetEmailTemplate(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if emailTemplate == nil {
+			return fmt.Errorf("email template %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateEmailTemplateConfig = `
+
+resource "auth0_email" "test_email" {
+	name 					= "sendgrid"
+	default_from_address 	= "accounts@example.com"
+
+	credentials {
+		api_key = "SG.test-api-key"
+	}
+}
+
+resource "auth0_email_template" "test_template" {
+	depends_on = [auth0_email.test_email]
+
+	template 				= "welcome_email"
+	body 					= "<html><body><h1>Welcome!</h1></body></html>"
+	from 					= "welcome@example.com"
+	subject 				= "Welcome"
+	url_lifetime_in_seconds = 3600
+}
+
+`
+
+const testUpdateEmailTemplateConfig = `
+
+resource "auth0_email" "test_email" {
+	name 					= "sendgrid"
+	default_from_address 	= "accounts@example.com"
+
+	credentials {
+		api_key = "SG.test-api-key"
+	}
+}
+
+resource "auth0_email_template" "test_template" {
+	depends_on = [auth0_email.test_email]
+
+	template 				= "welcome_email"
+	body 					= "<html><body><h1>Hello!</h1></body></html>"
+	from 					= "welcome@example.com"
+	subject 				= "Hello"
+	result_url 				= "https://example.com/welcome"
+	url_lifetime_in_seconds = 3600
+	enabled 				= false
+}
+
+`
+
+const testClearEmailTemplateConfig = `
+
+resource "auth0_email" "test_email" {
+	name 					= "sendgrid"
+	default_from_address 	= "accounts@example.com"
+
+	credentials {
+		api_key = "SG.test-api-key"
+	}
+}
+
+resource "auth0_email_template" "test_template" {
+	depends_on = [auth0_email.test_email]
+
+	template 	= "welcome_email"
+	body 		= "<html><body><h1>Hello!</h1></body></html>"
+	from 		= "welcome@example.com"
+	subject 	= "Hello"
+	enabled 	= false
+}
+
+`
@@ -0,0 +1,102 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0GuardianFactor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0GuardianFactorCreate,
+		Read:   resourceAuth0GuardianFactorRead,
+		Update: resourceAuth0GuardianFactorUpdate,
+		Delete: resourceAuth0GuardianFactorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"otp",
+					"sms",
+					"push-notification",
+					"webauthn-roaming",
+					"webauthn-platform",
+					"email",
+					"recovery-code",
+					"duo",
+				}, false),
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0GuardianFactorCreate(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceAuth0GuardianFactorUpdate(d, meta)
+}
+
+func resourceAuth0GuardianFactorRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	factors, err := auth0Client.GetGuardianFactors()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 guardian factors: %v", err)
+	}
+
+	for _, factor := range factors {
+		if factor.Name == d.Id() {
+			d.Set("name", factor.Name)
+			d.Set("enabled", factor.Enabled)
+			return nil
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAuth0GuardianFactorUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	enabled := d.Get("enabled").(bool)
+
+	err := auth0Client.SetGuardianFactorEnabled(d.Id(), enabled)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 guardian factor %s: %v", d.Id(), err)
+	}
+
+	return resourceAuth0GuardianFactorRead(d, meta)
+}
+
+// resourceAuth0GuardianFactorDelete disables the factor, factors are built into Auth0 and cannot be removed.
+func resourceAuth0GuardianFactorDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.SetGuardianFactorEnabled(d.Id(), false)
+
+	if err != nil {
+		return fmt.Errorf("could not disable auth0 guardian factor %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0GuardianFactor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0GuardianFactorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateGuardianFactorConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_factor.test_factor", "name", "otp"),
+					resource.TestCheckResourceAttr("auth0_guardian_factor.test_factor", "enabled", "true"),
+				),
+			},
+			{
+				Config: testUpdateGuardianFactorConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_factor.test_factor", "name", "otp"),
+					resource.TestCheckResourceAttr("auth0_guardian_factor.test_factor", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "auth0_guardian_factor.test_factor",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0GuardianFactorDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	factors, err := client.GetGuardianFactors()
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 guardian factors: %v", err)
+	}
+
+	for _, factor := range factors {
+		if factor.Name == "otp" && factor.Enabled {
+			return fmt.Errorf("guardian factor otp is still enabled")
+		}
+	}
+
+	return nil
+}
+
+const testCreateGuardianFactorConfig = `
+
+resource "auth0_guardian_factor" "test_factor" {
+	name 	= "otp"
+	enabled = true
+}
+
+`
+
+const testUpdateGuardianFactorConfig = `
+
+resource "auth0_guardian_factor" "test_factor" {
+	name 	= "otp"
+	enabled = false
+}
+
+`
@@ -0,0 +1,89 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	guardianPolicyId    = "guardian-policy"
+	guardianPolicyNever = "never"
+)
+
+func resourceAuth0GuardianPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0GuardianPolicyUpdate,
+		Read:   resourceAuth0GuardianPolicyRead,
+		Update: resourceAuth0GuardianPolicyUpdate,
+		Delete: resourceAuth0GuardianPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{guardianPolicyNever, "all-applications", "confidence-score"}, false),
+			},
+		},
+	}
+}
+
+func resourceAuth0GuardianPolicyRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	policies, err := auth0Client.GetGuardianPolicies()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 guardian policy: %v", err)
+	}
+
+	if len(policies) == 0 {
+		d.Set("policy", guardianPolicyNever)
+	} else {
+		d.Set("policy", policies[0])
+	}
+
+	return nil
+}
+
+func resourceAuth0GuardianPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	policy := d.Get("policy").(string)
+
+	// Auth0 represents "never" as the absence of any policy.
+	policies := []string{}
+	if policy != guardianPolicyNever {
+		policies = append(policies, policy)
+	}
+
+	err := auth0Client.SetGuardianPolicies(policies)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 guardian policy: %s error: %v", policy, err)
+	}
+
+	d.SetId(guardianPolicyId)
+
+	return resourceAuth0GuardianPolicyRead(d, meta)
+}
+
+func resourceAuth0GuardianPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.SetGuardianPolicies([]string{})
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 guardian policy: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0GuardianPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0GuardianPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateGuardianPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_policy.test_policy", "policy", "all-applications"),
+				),
+			},
+			{
+				Config: testUpdateGuardianPolicyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_policy.test_policy", "policy", "never"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0GuardianPolicyDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	policies, err := client.GetGuardianPolicies()
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 guardian policies: %v", err)
+	}
+
+	if len(policies) > 0 {
+		return fmt.Errorf("guardian policies still set, %+v", policies)
+	}
+
+	return nil
+}
+
+const testCreateGuardianPolicyConfig = `
+
+resource "auth0_guardian_policy" "test_policy" {
+	policy = "all-applications"
+}
+
+`
+
+const testUpdateGuardianPolicyConfig = `
+
+resource "auth0_guardian_policy" "test_policy" {
+	policy = "never"
+}
+
+`
@@ -0,0 +1,220 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	guardianPushProviderId      = "push-notification"
+	guardianPushProviderDefault = "guardian"
+)
+
+func resourceAuth0GuardianPushProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0GuardianPushProviderUpdate,
+		Read:   resourceAuth0GuardianPushProviderRead,
+		Update: resourceAuth0GuardianPushProviderUpdate,
+		Delete: resourceAuth0GuardianPushProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Credentials are never read back from Auth0.
+		Schema: map[string]*schema.Schema{
+			"selected_provider": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{guardianPushProviderDefault, "sns", "direct"}, false),
+			},
+			"apns": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bundle_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sandbox": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						// Base64 encoded PKCS12 certificate.
+						"p12": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"fcm": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"sns": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_access_key_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"aws_secret_access_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"aws_region": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sns_apns_platform_application_arn": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sns_gcm_platform_application_arn": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0GuardianPushProviderRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	provider, err := auth0Client.GetGuardianPushSelectedProvider()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 guardian push notification provider: %v", err)
+	}
+
+	d.Set("selected_provider", provider)
+
+	if _, ok := d.GetOk("apns"); ok {
+		apns, err := auth0Client.GetGuardianApnsSettings()
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 guardian apns settings: %v", err)
+		}
+
+		d.Set("apns", []interface{}{map[string]interface{}{
+			"bundle_id": apns.BundleId,
+			"sandbox":   apns.Sandbox,
+			"p12":       d.Get("apns.0.p12"),
+		}})
+	}
+
+	if _, ok := d.GetOk("sns"); ok {
+		sns, err := auth0Client.GetGuardianSnsSettings()
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 guardian sns settings: %v", err)
+		}
+
+		d.Set("sns", []interface{}{map[string]interface{}{
+			"aws_access_key_id":                 sns.AwsAccessKeyId,
+			"aws_secret_access_key":             d.Get("sns.0.aws_secret_access_key"),
+			"aws_region":                        sns.AwsRegion,
+			"sns_apns_platform_application_arn": sns.SnsApnsPlatformApplicationArn,
+			"sns_gcm_platform_application_arn":  sns.SnsGcmPlatformApplicationArn,
+		}})
+	}
+
+	return nil
+}
+
+func resourceAuth0GuardianPushProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	if apnsList := d.Get("apns").([]interface{}); len(apnsList) > 0 && apnsList[0] != nil {
+		apns := apnsList[0].(map[string]interface{})
+
+		err := auth0Client.SetGuardianApnsSettings(&GuardianApnsSettings{
+			BundleId: apns["bundle_id"].(string),
+			Sandbox:  apns["sandbox"].(bool),
+			P12:      apns["p12"].(string),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to update auth0 guardian apns settings: %v", err)
+		}
+	}
+
+	if fcmList := d.Get("fcm").([]interface{}); len(fcmList) > 0 && fcmList[0] != nil {
+		fcm := fcmList[0].(map[string]interface{})
+
+		err := auth0Client.SetGuardianFcmSettings(&GuardianFcmSettings{
+			ServerKey: fcm["server_key"].(string),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to update auth0 guardian fcm settings: %v", err)
+		}
+	}
+
+	if snsList := d.Get("sns").([]interface{}); len(snsList) > 0 && snsList[0] != nil {
+		sns := snsList[0].(map[string]interface{})
+
+		err := auth0Client.SetGuardianSnsSettings(&GuardianSnsSettings{
+			AwsAccessKeyId:                sns["aws_access_key_id"].(string),
+			AwsSecretAccessKey:            sns["aws_secret_access_key"].(string),
+			AwsRegion:                     sns["aws_region"].(string),
+			SnsApnsPlatformApplicationArn: sns["sns_apns_platform_application_arn"].(string),
+			SnsGcmPlatformApplicationArn:  sns["sns_gcm_platform_application_arn"].(string),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to update auth0 guardian sns settings: %v", err)
+		}
+	}
+
+	provider := d.Get("selected_provider").(string)
+
+	err := auth0Client.SetGuardianPushSelectedProvider(provider)
+
+	if err != nil {
+		return fmt.Errorf("failed to select auth0 guardian push notification provider %s: %v", provider, err)
+	}
+
+	d.SetId(guardianPushProviderId)
+
+	return resourceAuth0GuardianPushProviderRead(d, meta)
+}
+
+// resourceAuth0GuardianPushProviderDelete switches push notifications back to the Guardian app.
+func resourceAuth0GuardianPushProviderDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.SetGuardianPushSelectedProvider(guardianPushProviderDefault)
+
+	if err != nil {
+		return fmt.Errorf("could not reset auth0 guardian push notification provider: %v", err)
+	}
+
+	return nil
+}
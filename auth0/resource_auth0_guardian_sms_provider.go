@@ -0,0 +1,138 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	guardianSmsProviderId      = "sms"
+	guardianSmsProviderDefault = "auth0"
+	guardianSmsProviderTwilio  = "twilio"
+)
+
+func resourceAuth0GuardianSmsProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0GuardianSmsProviderUpdate,
+		Read:   resourceAuth0GuardianSmsProviderRead,
+		Update: resourceAuth0GuardianSmsProviderUpdate,
+		Delete: resourceAuth0GuardianSmsProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"selected_provider": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{guardianSmsProviderDefault, guardianSmsProviderTwilio, "phone-message-hook"}, false),
+			},
+			"twilio": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"messaging_service_sid": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sid": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						// Never read back from Auth0.
+						"auth_token": &schema.Schema{
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0GuardianSmsProviderRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	provider, err := auth0Client.GetGuardianSmsSelectedProvider()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 guardian sms provider: %v", err)
+	}
+
+	d.Set("selected_provider", provider)
+
+	if _, ok := d.GetOk("twilio"); ok {
+		twilio, err := auth0Client.GetGuardianTwilioSettings()
+
+		if err != nil {
+			return fmt.Errorf("could not find auth0 guardian twilio settings: %v", err)
+		}
+
+		d.Set("twilio", []interface{}{map[string]interface{}{
+			"from":                  twilio.From,
+			"messaging_service_sid": twilio.MessagingServiceSid,
+			"sid":                   twilio.Sid,
+			"auth_token":            d.Get("twilio.0.auth_token"),
+		}})
+	}
+
+	return nil
+}
+
+func resourceAuth0GuardianSmsProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	if twilioList := d.Get("twilio").([]interface{}); len(twilioList) > 0 && twilioList[0] != nil {
+		twilio := twilioList[0].(map[string]interface{})
+
+		err := auth0Client.SetGuardianTwilioSettings(&GuardianTwilioSettings{
+			From:                twilio["from"].(string),
+			MessagingServiceSid: twilio["messaging_service_sid"].(string),
+			Sid:                 twilio["sid"].(string),
+			AuthToken:           twilio["auth_token"].(string),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to update auth0 guardian twilio settings: %v", err)
+		}
+	}
+
+	provider := d.Get("selected_provider").(string)
+
+	err := auth0Client.SetGuardianSmsSelectedProvider(provider)
+
+	if err != nil {
+		return fmt.Errorf("failed to select auth0 guardian sms provider %s: %v", provider, err)
+	}
+
+	d.SetId(guardianSmsProviderId)
+
+	return resourceAuth0GuardianSmsProviderRead(d, meta)
+}
+
+// resourceAuth0GuardianSmsProviderDelete switches SMS back to the provider built into Auth0.
+func resourceAuth0GuardianSmsProviderDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.SetGuardianSmsSelectedProvider(guardianSmsProviderDefault)
+
+	if err != nil {
+		return fmt.Errorf("could not reset auth0 guardian sms provider: %v", err)
+	}
+
+	return nil
+}
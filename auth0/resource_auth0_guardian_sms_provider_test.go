@@ -0,0 +1,52 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0GuardianSmsProvider(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateGuardianSmsProviderConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_sms_provider.test_sms", "selected_provider", "twilio"),
+					resource.TestCheckResourceAttr("auth0_guardian_sms_provider.test_sms", "twilio.0.from", "+15555555555"),
+					resource.TestCheckResourceAttr("auth0_guardian_sms_provider.test_sms", "twilio.0.sid", "AC00000000000000000000000000000000"),
+				),
+			},
+			{
+				Config: testUpdateGuardianSmsProviderConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_guardian_sms_provider.test_sms", "selected_provider", "auth0"),
+				),
+			},
+		},
+	})
+}
+
+const testCreateGuardianSmsProviderConfig = `
+
+resource "auth0_guardian_sms_provider" "test_sms" {
+	selected_provider = "twilio"
+
+	twilio {
+		from 		= "+15555555555"
+		sid 		= "AC00000000000000000000000000000000"
+		auth_token 	= "test-auth-token"
+	}
+}
+
+`
+
+const testUpdateGuardianSmsProviderConfig = `
+
+resource "auth0_guardian_sms_provider" "test_sms" {
+	selected_provider = "auth0"
+}
+
+`
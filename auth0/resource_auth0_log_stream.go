@@ -0,0 +1,269 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0LogStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0LogStreamCreate,
+		Read:   resourceAuth0LogStreamRead,
+		Update: resourceAuth0LogStreamUpdate,
+		Delete: resourceAuth0LogStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "eventbridge", "datadog", "splunk", "sumo"}, false),
+			},
+			// Auth0 sets the status to "suspended" when it repeatedly fails to deliver logs, which then shows up
+			// as a change in plan.
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "paused"}, false),
+			},
+			// Event categories to stream, e.g. "auth.login.fail", all events are streamed when empty.
+			"filters": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			// Secrets of the sink are never read back from Auth0.
+			"sink": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"http_authorization": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"http_content_format": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"JSONLINES", "JSONARRAY", "JSONOBJECT"}, false),
+						},
+						"http_content_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"aws_account_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"aws_region": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"aws_partner_event_source": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datadog_region": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"datadog_api_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"splunk_domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"splunk_token": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"splunk_port": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"splunk_secure": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sumo_source_address": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0LogStreamCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	logStreamRequest := createLogStreamRequestFromResourceData(d)
+	logStreamRequest.Type = readStringFromResource(d, "type")
+
+	// A stream cannot be created paused, so the status is set by the update below.
+	status := logStreamRequest.Status
+	logStreamRequest.Status = ""
+
+	logStream, err := auth0Client.CreateLogStream(logStreamRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 log stream: %s error: %v", logStreamRequest.Name, err)
+	}
+
+	d.SetId(logStream.Id)
+
+	if status != "" && status != logStream.Status {
+		_, err = auth0Client.UpdateLogStreamById(logStream.Id, &LogStreamRequest{Status: status, Filters: logStreamRequest.Filters})
+
+		if err != nil {
+			return fmt.Errorf("failed to set status of auth0 log stream: %s error: %v", logStreamRequest.Name, err)
+		}
+	}
+
+	return resourceAuth0LogStreamRead(d, meta)
+}
+
+func resourceAuth0LogStreamRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	logStream, err := auth0Client.GetLogStreamById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 log stream: %v", err)
+	}
+
+	if logStream == nil {
+		d.SetId("")
+		return nil
+	}
+
+	filters := make([]string, 0, len(logStream.Filters))
+	for _, filter := range logStream.Filters {
+		filters = append(filters, filter.Name)
+	}
+
+	d.Set("name", logStream.Name)
+	d.Set("type", logStream.Type)
+	d.Set("status", logStream.Status)
+	d.Set("filters", filters)
+
+	if logStream.Sink != nil {
+		d.Set("sink", []interface{}{map[string]interface{}{
+			"http_endpoint":            logStream.Sink.HttpEndpoint,
+			"http_authorization":       d.Get("sink.0.http_authorization"),
+			"http_content_format":      logStream.Sink.HttpContentFormat,
+			"http_content_type":        logStream.Sink.HttpContentType,
+			"aws_account_id":           logStream.Sink.AwsAccountId,
+			"aws_region":               logStream.Sink.AwsRegion,
+			"aws_partner_event_source": logStream.Sink.AwsPartnerEventSource,
+			"datadog_region":           logStream.Sink.DatadogRegion,
+			"datadog_api_key":          d.Get("sink.0.datadog_api_key"),
+			"splunk_domain":            logStream.Sink.SplunkDomain,
+			"splunk_token":             d.Get("sink.0.splunk_token"),
+			"splunk_port":              logStream.Sink.SplunkPort,
+			"splunk_secure":            logStream.Sink.SplunkSecure != nil && *logStream.Sink.SplunkSecure,
+			"sumo_source_address":      d.Get("sink.0.sumo_source_address"),
+		}})
+	}
+
+	return nil
+}
+
+func resourceAuth0LogStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	logStreamRequest := createLogStreamRequestFromResourceData(d)
+
+	// The sink of an eventbridge stream cannot be changed, any change to it recreates the stream.
+	if d.Get("type").(string) == "eventbridge" {
+		logStreamRequest.Sink = nil
+	}
+
+	_, err := auth0Client.UpdateLogStreamById(d.Id(), logStreamRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 log stream: %s error: %v", logStreamRequest.Name, err)
+	}
+
+	return resourceAuth0LogStreamRead(d, meta)
+}
+
+func resourceAuth0LogStreamDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	err := auth0Client.DeleteLogStreamById(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 log stream: %v", err)
+	}
+
+	return nil
+}
+
+func createLogStreamRequestFromResourceData(d *schema.ResourceData) *LogStreamRequest {
+	logStreamRequest := &LogStreamRequest{Filters: []LogStreamFilter{}}
+
+	logStreamRequest.Name = readStringFromResource(d, "name")
+	logStreamRequest.Status = readStringFromResource(d, "status")
+
+	for _, category := range readStringArrayFromResource(d, "filters") {
+		logStreamRequest.Filters = append(logStreamRequest.Filters, LogStreamFilter{Type: "category", Name: category})
+	}
+
+	if sinkList := d.Get("sink").([]interface{}); len(sinkList) > 0 && sinkList[0] != nil {
+		sink := sinkList[0].(map[string]interface{})
+
+		logStreamRequest.Sink = &LogStreamSink{
+			HttpEndpoint:      sink["http_endpoint"].(string),
+			HttpAuthorization: sink["http_authorization"].(string),
+			HttpContentFormat: sink["http_content_format"].(string),
+			HttpContentType:   sink["http_content_type"].(string),
+			AwsAccountId:      sink["aws_account_id"].(string),
+			AwsRegion:         sink["aws_region"].(string),
+			DatadogRegion:     sink["datadog_region"].(string),
+			DatadogApiKey:     sink["datadog_api_key"].(string),
+			SplunkDomain:      sink["splunk_domain"].(string),
+			SplunkToken:       sink["splunk_token"].(string),
+			SplunkPort:        sink["splunk_port"].(string),
+			SumoSourceAddress: sink["sumo_source_address"].(string),
+		}
+
+		// Sent even when false, otherwise a splunk stream could never be switched back to an insecure connection.
+		if d.Get("type").(string) == "splunk" {
+			splunkSecure := sink["splunk_secure"].(bool)
+			logStreamRequest.Sink.SplunkSecure = &splunkSecure
+		}
+	}
+
+	return logStreamRequest
+}
@@ -0,0 +1,168 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0LogStream(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0LogStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateLogStreamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0LogStreamExists("auth0_log_stream.test_stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "name", "test stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "type", "http"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "status", "active"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "sink.0.http_endpoint", "https://siem.example.com/auth0"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "sink.0.http_content_format", "JSONLINES"),
+				),
+			},
+			{
+				Config: testUpdateLogStreamConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0LogStreamExists("auth0_log_stream.test_stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "name", "updated test stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "status", "paused"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "filters.0", "auth.login.fail"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "filters.1", "auth.signup.fail"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "sink.0.http_content_format", "JSONARRAY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuth0LogStreamSplunk(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0LogStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testSplunkLogStreamConfig, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0LogStreamExists("auth0_log_stream.test_stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "type", "splunk"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "sink.0.splunk_secure", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testSplunkLogStreamConfig, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0LogStreamExists("auth0_log_stream.test_stream"),
+					resource.TestCheckResourceAttr("auth0_log_stream.test_stream", "sink.0.splunk_secure", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0LogStreamDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	logStreams := getResourcesByType("auth0_log_stream", state)
+
+	if len(logStreams) != 1 {
+		return fmt.Errorf("expecting only 1 auth0 log stream resource found %v", len(logStreams))
+	}
+
+	response, err := client.GetLogStreamById(logStreams[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 log stream by id: %v", err)
+	}
+
+	if response != nil {
+		return fmt.Errorf("log stream %s still exists, %+v", logStreams[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0LogStreamExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		logStream, err := client.GetLogStreamById(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if logStream == nil {
+			return fmt.Errorf("log stream with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateLogStreamConfig = `
+
+resource "auth0_log_stream" "test_stream" {
+	name = "test stream"
+	type = "http"
+
+	sink {
+		http_endpoint 		= "https://siem.example.com/auth0"
+		http_authorization 	= "Bearer secret"
+		http_content_format = "JSONLINES"
+		http_content_type 	= "application/json"
+	}
+}
+
+`
+
+const testUpdateLogStreamConfig = `
+
+resource "auth0_log_stream" "test_stream" {
+	name 	= "updated test stream"
+	type 	= "http"
+	status 	= "paused"
+	filters = ["auth.login.fail", "auth.signup.fail"]
+
+	sink {
+		http_endpoint 		= "https://siem.example.com/auth0"
+		http_authorization 	= "Bearer secret"
+		http_content_format = "JSONARRAY"
+		http_content_type 	= "application/json"
+	}
+}
+
+`
+
+const testSplunkLogStreamConfig = `
+
+resource "auth0_log_stream" "test_stream" {
+	name 	= "test splunk stream"
+	type 	= "splunk"
+
+	sink {
+		splunk_domain 	= "splunk.example.com"
+		splunk_token 	= "12a34ab5-c6d7-8901-23ef-456b7c89d0c1"
+		splunk_port 	= "8088"
+		splunk_secure 	= %t
+	}
+}
+
+`
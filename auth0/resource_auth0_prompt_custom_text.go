@@ -0,0 +1,127 @@
+package auth0
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuth0PromptCustomText() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0PromptCustomTextSet,
+		Read:   resourceAuth0PromptCustomTextRead,
+		Update: resourceAuth0PromptCustomTextSet,
+		Delete: resourceAuth0PromptCustomTextDelete,
+
+		// Imported using "<prompt>:<language>", e.g. "login:en".
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prompt": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"language": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// JSON document of the custom text, keyed by screen then by text key.
+			"body": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceAuth0PromptCustomTextSet(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	prompt := d.Get("prompt").(string)
+	language := d.Get("language").(string)
+
+	customText, err := structure.ExpandJsonFromString(d.Get("body").(string))
+
+	if err != nil {
+		return fmt.Errorf("could not parse custom text of prompt %s: %v", prompt, err)
+	}
+
+	err = auth0Client.SetPromptCustomText(prompt, language, customText)
+
+	if err != nil {
+		return fmt.Errorf("failed to set auth0 custom text of prompt %s in %s: %v", prompt, language, err)
+	}
+
+	d.SetId(prompt + ":" + language)
+
+	return resourceAuth0PromptCustomTextRead(d, meta)
+}
+
+func resourceAuth0PromptCustomTextRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	prompt, language, err := parsePromptCustomTextId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	customText, err := auth0Client.GetPromptCustomText(prompt, language)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 custom text of prompt %s in %s: %v", prompt, language, err)
+	}
+
+	body, err := json.Marshal(customText)
+
+	if err != nil {
+		return fmt.Errorf("could not serialise custom text of prompt %s: %v", prompt, err)
+	}
+
+	d.Set("prompt", prompt)
+	d.Set("language", language)
+	d.Set("body", string(body))
+
+	return nil
+}
+
+func resourceAuth0PromptCustomTextDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	prompt, language, err := parsePromptCustomTextId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = auth0Client.SetPromptCustomText(prompt, language, map[string]interface{}{})
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 custom text of prompt %s in %s: %v", prompt, language, err)
+	}
+
+	return nil
+}
+
+func parsePromptCustomTextId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid prompt custom text id %q, expected <prompt>:<language>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
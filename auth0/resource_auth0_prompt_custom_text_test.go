@@ -0,0 +1,95 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0PromptCustomText(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0PromptCustomTextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePromptCustomTextConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.test_text", "id", "login:en"),
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.test_text", "body", `{"login":{"title":"Welcome"}}`),
+				),
+			},
+			{
+				// Only the formatting differs from the previous step, so no changes must be planned.
+				Config:   testReformatPromptCustomTextConfig,
+				PlanOnly: true,
+			},
+			{
+				Config: testUpdatePromptCustomTextConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_prompt_custom_text.test_text", "body", `{"login":{"description":"Log in to continue","title":"Hello"}}`),
+				),
+			},
+			{
+				ResourceName:      "auth0_prompt_custom_text.test_text",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0PromptCustomTextDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	response, err := client.GetPromptCustomText("login", "en")
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 prompt custom text: %v", err)
+	}
+
+	if len(response) > 0 {
+		return fmt.Errorf("custom text of prompt login still exists, %+v", response)
+	}
+
+	return nil
+}
+
+const testCreatePromptCustomTextConfig = `
+
+resource "auth0_prompt_custom_text" "test_text" {
+	prompt 		= "login"
+	language 	= "en"
+	body 		= jsonencode({ login = { title = "Welcome" } })
+}
+
+`
+
+const testReformatPromptCustomTextConfig = `
+
+resource "auth0_prompt_custom_text" "test_text" {
+	prompt 		= "login"
+	language 	= "en"
+	body 		= <<EOT
+{
+	"login": {
+		"title": "Welcome"
+	}
+}
+EOT
+}
+
+`
+
+const testUpdatePromptCustomTextConfig = `
+
+resource "auth0_prompt_custom_text" "test_text" {
+	prompt 		= "login"
+	language 	= "en"
+	body 		= jsonencode({ login = { title = "Hello", description = "Log in to continue" } })
+}
+
+`
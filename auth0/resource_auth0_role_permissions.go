@@ -0,0 +1,109 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAuth0RolePermissions manages the full set of permissions of a role independently of the role itself,
+// permissions granted outside of Terraform are removed.
+func resourceAuth0RolePermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0RolePermissionsCreate,
+		Read:   resourceAuth0RolePermissionsRead,
+		Update: resourceAuth0RolePermissionsUpdate,
+		Delete: resourceAuth0RolePermissionsDelete,
+
+		// Imported using the role id.
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permissions": permissionsSchema(),
+		},
+	}
+}
+
+func resourceAuth0RolePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId(d.Get("role_id").(string))
+
+	return resourceAuth0RolePermissionsUpdate(d, meta)
+}
+
+func resourceAuth0RolePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	permissions, err := auth0Client.GetRolePermissions(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 role permissions: %v", err)
+	}
+
+	if permissions == nil {
+		d.SetId("")
+	} else {
+		d.Set("role_id", d.Id())
+		d.Set("permissions", flattenPermissions(permissions))
+	}
+
+	return nil
+}
+
+func resourceAuth0RolePermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	current, err := auth0Client.GetRolePermissions(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 role permissions: %v", err)
+	}
+
+	if current == nil {
+		return fmt.Errorf("auth0 role %s not found", d.Id())
+	}
+
+	toAdd, toRemove := diffPermissions(current, expandPermissions(d.Get("permissions").(*schema.Set)))
+
+	if len(toRemove) > 0 {
+		if err := auth0Client.RemoveRolePermissions(d.Id(), toRemove); err != nil {
+			return fmt.Errorf("failed to remove permissions from auth0 role %s: %v", d.Id(), err)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := auth0Client.AddRolePermissions(d.Id(), toAdd); err != nil {
+			return fmt.Errorf("failed to add permissions to auth0 role %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceAuth0RolePermissionsRead(d, meta)
+}
+
+func resourceAuth0RolePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	permissions := expandPermissions(d.Get("permissions").(*schema.Set))
+
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	err := auth0Client.RemoveRolePermissions(d.Id(), permissions)
+
+	if err != nil {
+		return fmt.Errorf("could not remove auth0 role permissions: %v", err)
+	}
+
+	return nil
+}
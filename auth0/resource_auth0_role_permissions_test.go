@@ -0,0 +1,73 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0RolePermissions(t *testing.T) {
+	managementApi := "https://" + os.Getenv("AUTH0_DOMAIN") + "/api/v2/"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckRoleId(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0RolePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateRolePermissionsConfig, os.Getenv("AUTH0_ROLE_ID"), managementApi),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_role_permissions.test_permissions", "role_id", os.Getenv("AUTH0_ROLE_ID")),
+					resource.TestCheckResourceAttr("auth0_role_permissions.test_permissions", "permissions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "auth0_role_permissions.test_permissions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccPreCheckRoleId requires the id of a role without any permission, as roles are not managed by the provider.
+func testAccPreCheckRoleId(t *testing.T) {
+	if v := os.Getenv("AUTH0_ROLE_ID"); v == "" {
+		t.Fatal("AUTH0_ROLE_ID must be set for role permissions acceptance tests")
+	}
+}
+
+func testAccCheckAuth0RolePermissionsDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	for _, rs := range getResourcesByType("auth0_role_permissions", state) {
+		permissions, err := client.GetRolePermissions(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error calling get auth0 role permissions: %v", err)
+		}
+
+		if len(permissions) != 0 {
+			return fmt.Errorf("role %s still has permissions %+v", rs.Primary.ID, permissions)
+		}
+	}
+
+	return nil
+}
+
+const testCreateRolePermissionsConfig = `
+
+resource "auth0_role_permissions" "test_permissions" {
+	role_id = "%s"
+
+	permissions {
+		resource_server_identifier 	= "%s"
+		name 						= "read:users"
+	}
+}
+
+`
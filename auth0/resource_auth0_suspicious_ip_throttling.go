@@ -0,0 +1,159 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const suspiciousIpThrottlingId = "suspicious-ip-throttling"
+
+func resourceAuth0SuspiciousIpThrottling() *schema.Resource {
+	stageSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": &schema.Schema{
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				// Interval (in milliseconds) at which a new attempt is granted.
+				"rate": &schema.Schema{
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAuth0SuspiciousIpThrottlingUpdate,
+		Read:   resourceAuth0SuspiciousIpThrottlingRead,
+		Update: resourceAuth0SuspiciousIpThrottlingUpdate,
+		Delete: resourceAuth0SuspiciousIpThrottlingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"shields": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"block", "admin_notification"}, false),
+				},
+				Optional: true,
+			},
+			"allowlist": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIpAddressOrCidr,
+				},
+				Optional: true,
+			},
+			"pre_login":             stageSchema,
+			"pre_user_registration": stageSchema,
+		},
+	}
+}
+
+func resourceAuth0SuspiciousIpThrottlingRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	suspiciousIpThrottling, err := auth0Client.GetSuspiciousIpThrottling()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 suspicious IP throttling: %v", err)
+	}
+
+	d.Set("enabled", suspiciousIpThrottling.Enabled)
+	d.Set("shields", suspiciousIpThrottling.Shields)
+	d.Set("allowlist", suspiciousIpThrottling.Allowlist)
+
+	if suspiciousIpThrottling.Stage != nil {
+		d.Set("pre_login", flattenSuspiciousIpThrottlingStageLimits(suspiciousIpThrottling.Stage.PreLogin))
+		d.Set("pre_user_registration", flattenSuspiciousIpThrottlingStageLimits(suspiciousIpThrottling.Stage.PreUserRegistration))
+	}
+
+	return nil
+}
+
+func resourceAuth0SuspiciousIpThrottlingUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	suspiciousIpThrottling := &SuspiciousIpThrottling{
+		Enabled:   d.Get("enabled").(bool),
+		Shields:   readStringSetFromResource(d, "shields"),
+		Allowlist: readStringSetFromResource(d, "allowlist"),
+		Stage: &SuspiciousIpThrottlingStage{
+			PreLogin:            expandSuspiciousIpThrottlingStageLimits(d.Get("pre_login").([]interface{})),
+			PreUserRegistration: expandSuspiciousIpThrottlingStageLimits(d.Get("pre_user_registration").([]interface{})),
+		},
+	}
+
+	_, err := auth0Client.UpdateSuspiciousIpThrottling(suspiciousIpThrottling)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 suspicious IP throttling: %v error: %v", suspiciousIpThrottling, err)
+	}
+
+	d.SetId(suspiciousIpThrottlingId)
+
+	return resourceAuth0SuspiciousIpThrottlingRead(d, meta)
+}
+
+// resourceAuth0SuspiciousIpThrottlingDelete disables the throttling, the settings themselves cannot be removed.
+func resourceAuth0SuspiciousIpThrottlingDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	_, err := auth0Client.UpdateSuspiciousIpThrottling(&SuspiciousIpThrottling{
+		Enabled:   false,
+		Shields:   readStringSetFromResource(d, "shields"),
+		Allowlist: readStringSetFromResource(d, "allowlist"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not disable auth0 suspicious IP throttling: %v", err)
+	}
+
+	return nil
+}
+
+func expandSuspiciousIpThrottlingStageLimits(stage []interface{}) *SuspiciousIpThrottlingStageLimits {
+	if len(stage) == 0 || stage[0] == nil {
+		return nil
+	}
+
+	limits := stage[0].(map[string]interface{})
+
+	return &SuspiciousIpThrottlingStageLimits{
+		MaxAttempts: limits["max_attempts"].(int),
+		Rate:        limits["rate"].(int),
+	}
+}
+
+func flattenSuspiciousIpThrottlingStageLimits(limits *SuspiciousIpThrottlingStageLimits) []interface{} {
+	if limits == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"max_attempts": limits.MaxAttempts,
+		"rate":         limits.Rate,
+	}}
+}
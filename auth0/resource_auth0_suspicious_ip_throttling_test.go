@@ -0,0 +1,70 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0SuspiciousIpThrottling(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateSuspiciousIpThrottlingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "shields.#", "2"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "allowlist.#", "1"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "pre_login.0.max_attempts", "100"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "pre_login.0.rate", "864000"),
+				),
+			},
+			{
+				Config: testUpdateSuspiciousIpThrottlingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "enabled", "true"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "allowlist.#", "2"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "pre_user_registration.0.max_attempts", "50"),
+					resource.TestCheckResourceAttr("auth0_suspicious_ip_throttling.test_throttling", "pre_user_registration.0.rate", "1200"),
+				),
+			},
+		},
+	})
+}
+
+const testCreateSuspiciousIpThrottlingConfig = `
+
+resource "auth0_suspicious_ip_throttling" "test_throttling" {
+	enabled 	= true
+	shields 	= ["block", "admin_notification"]
+	allowlist 	= ["10.0.0.0/8"]
+
+	pre_login {
+		max_attempts 	= 100
+		rate 			= 864000
+	}
+}
+
+`
+
+const testUpdateSuspiciousIpThrottlingConfig = `
+
+resource "auth0_suspicious_ip_throttling" "test_throttling" {
+	enabled 	= true
+	shields 	= ["block", "admin_notification"]
+	allowlist 	= ["10.0.0.0/8", "2001:db8::/32"]
+
+	pre_login {
+		max_attempts 	= 100
+		rate 			= 864000
+	}
+
+	pre_user_registration {
+		max_attempts 	= 50
+		rate 			= 1200
+	}
+}
+
+`
@@ -0,0 +1,275 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tenantSettingsId is the ID given to the singleton tenant resource, the settings always belong to the
+// tenant the provider is authenticated against.
+const tenantSettingsId = "tenant"
+
+func resourceAuth0Tenant() *schema.Resource {
+	flagsSchema := map[string]*schema.Schema{}
+	for name := range tenantFlagFields(&TenantFlags{}) {
+		flagsSchema[name] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceAuth0TenantCreate,
+		Read:   resourceAuth0TenantRead,
+		Update: resourceAuth0TenantUpdate,
+		Delete: resourceAuth0TenantDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAuth0TenantCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"friendly_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"support_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"support_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_audience": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_directory": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"session_lifetime": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Login session lifetime (in hours)",
+			},
+			"idle_session_lifetime": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+				Description: "Idle login session lifetime (in hours)",
+			},
+			"allowed_logout_urls": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"enabled_locales": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+			},
+			"sandbox_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flags": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: flagsSchema,
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0TenantCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	settings := createTenantSettingsFromResourceData(d)
+
+	_, err := auth0Client.UpdateTenantSettings(settings)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 tenant settings: %v error: %v", settings, err)
+	}
+
+	d.SetId(tenantSettingsId)
+
+	return resourceAuth0TenantRead(d, meta)
+}
+
+func resourceAuth0TenantRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	settings, err := auth0Client.GetTenantSettings()
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 tenant settings: %v", err)
+	}
+
+	d.Set("friendly_name", settings.FriendlyName)
+	d.Set("support_email", settings.SupportEmail)
+	d.Set("support_url", settings.SupportUrl)
+	d.Set("default_audience", settings.DefaultAudience)
+	d.Set("default_directory", settings.DefaultDirectory)
+	d.Set("session_lifetime", settings.SessionLifetime)
+	d.Set("idle_session_lifetime", settings.IdleSessionLifetime)
+	d.Set("allowed_logout_urls", settings.AllowedLogoutUrls)
+	d.Set("enabled_locales", settings.EnabledLocales)
+	d.Set("sandbox_version", settings.SandboxVersion)
+
+	if settings.Flags != nil {
+		flags := map[string]interface{}{}
+		for name, field := range tenantFlagFields(settings.Flags) {
+			flags[name] = *field != nil && **field
+		}
+
+		d.Set("flags", []interface{}{flags})
+	}
+
+	return nil
+}
+
+func resourceAuth0TenantUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	settings := createTenantSettingsFromResourceData(d)
+
+	_, err := auth0Client.UpdateTenantSettings(settings)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 tenant settings: %v error: %v", settings, err)
+	}
+
+	return resourceAuth0TenantRead(d, meta)
+}
+
+// resourceAuth0TenantDelete only forgets the tenant settings, they cannot be removed from a tenant.
+func resourceAuth0TenantDelete(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceAuth0TenantCustomizeDiff plans the removal of every item from a list explicitly configured as empty,
+// which would otherwise keep its computed value.
+func resourceAuth0TenantCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	for _, key := range []string{"allowed_logout_urls", "enabled_locales"} {
+		if old, _ := d.GetChange(key); isEmptyListInConfig(d, key) && len(old.([]interface{})) > 0 {
+			if err := d.SetNew(key, []interface{}{}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func createTenantSettingsFromResourceData(d *schema.ResourceData) *TenantSettings {
+	settings := &TenantSettings{}
+
+	settings.FriendlyName = readStringFromResource(d, "friendly_name")
+	settings.SupportEmail = readStringFromResource(d, "support_email")
+	settings.SupportUrl = readStringFromResource(d, "support_url")
+	settings.DefaultAudience = readStringFromResource(d, "default_audience")
+	settings.DefaultDirectory = readStringFromResource(d, "default_directory")
+	settings.SessionLifetime = d.Get("session_lifetime").(float64)
+	settings.IdleSessionLifetime = d.Get("idle_session_lifetime").(float64)
+	settings.AllowedLogoutUrls = readStringArrayFromResource(d, "allowed_logout_urls")
+	settings.EnabledLocales = readStringArrayFromResource(d, "enabled_locales")
+	settings.SandboxVersion = readStringFromResource(d, "sandbox_version")
+
+	// The lists are computed, so they are only cleared in Auth0 when explicitly configured as empty.
+	for key, field := range map[string]*[]string{
+		"allowed_logout_urls": &settings.AllowedLogoutUrls,
+		"enabled_locales":     &settings.EnabledLocales,
+	} {
+		if isEmptyListInConfig(d, key) {
+			*field = []string{}
+			settings.ForceSendFields = append(settings.ForceSendFields, key)
+		}
+	}
+
+	if flagsList := d.Get("flags").([]interface{}); len(flagsList) > 0 && flagsList[0] != nil {
+		values := flagsList[0].(map[string]interface{})
+		configured := configuredTenantFlags(d)
+		settings.Flags = &TenantFlags{}
+
+		// Flags left out of the configuration keep the value they have in the tenant.
+		for name, field := range tenantFlagFields(settings.Flags) {
+			if configured[name] || d.HasChange("flags.0."+name) {
+				value := values[name].(bool)
+				*field = &value
+			}
+		}
+	}
+
+	return settings
+}
+
+// configuredTenantFlags returns the names of the flags set in the configuration, the flags block being computed
+// the state also holds the flags which are only managed in Auth0.
+func configuredTenantFlags(d *schema.ResourceData) map[string]bool {
+	configured := map[string]bool{}
+
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return configured
+	}
+
+	flags := config.GetAttr("flags")
+	if !flags.IsKnown() || flags.IsNull() || flags.LengthInt() == 0 {
+		return configured
+	}
+
+	block := flags.AsValueSlice()[0]
+	for name := range tenantFlagFields(&TenantFlags{}) {
+		if value := block.GetAttr(name); !value.IsNull() {
+			configured[name] = true
+		}
+	}
+
+	return configured
+}
+
+// tenantFlagFields maps the flags attribute names to the corresponding fields of flags.
+func tenantFlagFields(flags *TenantFlags) map[string]**bool {
+	return map[string]**bool{
+		"enable_client_connections":              &flags.EnableClientConnections,
+		"enable_apis_section":                    &flags.EnableApisSection,
+		"enable_pipeline2":                       &flags.EnablePipeline2,
+		"enable_dynamic_client_registration":     &flags.EnableDynamicClientRegistration,
+		"enable_custom_domain_in_emails":         &flags.EnableCustomDomainInEmails,
+		"enable_legacy_logs_search_v2":           &flags.EnableLegacyLogsSearchV2,
+		"disable_clickjack_protection_headers":   &flags.DisableClickjackProtectionHeaders,
+		"enable_public_signup_user_exists_error": &flags.EnablePublicSignupUserExistsError,
+		"use_scope_descriptions_for_consent":     &flags.UseScopeDescriptionsForConsent,
+		"revoke_refresh_token_grant":             &flags.RevokeRefreshTokenGrant,
+		"disable_management_api_sms_obfuscation": &flags.DisableManagementApiSmsObfuscation,
+		"enable_sso":                             &flags.EnableSso,
+	}
+}
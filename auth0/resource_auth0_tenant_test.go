@@ -0,0 +1,117 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0Tenant(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "friendly_name", "Test Tenant"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "support_email", "support@example.com"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "session_lifetime", "48"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "allowed_logout_urls.0", "https://example.com/logout"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "flags.0.enable_client_connections", "false"),
+				),
+			},
+			{
+				Config: testUpdateTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "friendly_name", "Updated Test Tenant"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "support_email", "help@example.com"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "session_lifetime", "72"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "idle_session_lifetime", "24"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "flags.0.enable_client_connections", "true"),
+				),
+			},
+			{
+				Config: testUnconfiguredFlagTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "flags.0.use_scope_descriptions_for_consent", "true"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "flags.0.enable_client_connections", "true"),
+					resource.TestCheckResourceAttr("auth0_tenant.test_tenant", "allowed_logout_urls.#", "0"),
+					testAccCheckAuth0TenantFlagEnabled("enable_client_connections"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0TenantFlagEnabled(name string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*AuthClient)
+
+		settings, err := client.GetTenantSettings()
+
+		if err != nil {
+			return err
+		}
+
+		if settings.Flags == nil {
+			return fmt.Errorf("tenant has no flags")
+		}
+
+		if field := tenantFlagFields(settings.Flags)[name]; *field == nil || !**field {
+			return fmt.Errorf("tenant flag %s is not enabled", name)
+		}
+
+		return nil
+	}
+}
+
+const testCreateTenantConfig = `
+
+resource "auth0_tenant" "test_tenant" {
+	friendly_name 		= "Test Tenant"
+	support_email 		= "support@example.com"
+	session_lifetime 	= 48
+	allowed_logout_urls = ["https://example.com/logout"]
+
+	flags {
+		enable_client_connections = false
+	}
+}
+
+`
+
+const testUpdateTenantConfig = `
+
+resource "auth0_tenant" "test_tenant" {
+	friendly_name 			= "Updated Test Tenant"
+	support_email 			= "help@example.com"
+	session_lifetime 		= 72
+	idle_session_lifetime 	= 24
+	allowed_logout_urls 	= ["https://example.com/logout"]
+
+	flags {
+		enable_client_connections = true
+	}
+}
+
+`
+
+const testUnconfiguredFlagTenantConfig = `
+
+resource "auth0_tenant" "test_tenant" {
+	friendly_name 			= "Updated Test Tenant"
+	support_email 			= "help@example.com"
+	session_lifetime 		= 72
+	idle_session_lifetime 	= 24
+	allowed_logout_urls 	= []
+
+	flags {
+		use_scope_descriptions_for_consent = true
+	}
+}
+
+`
@@ -0,0 +1,148 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var triggerBindingTriggers = []string{
+	"post-login",
+	"credentials-exchange",
+	"pre-user-registration",
+	"post-change-password",
+	"send-phone-message",
+}
+
+func resourceAuth0TriggerBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0TriggerBindingCreate,
+		Read:   resourceAuth0TriggerBindingRead,
+		Update: resourceAuth0TriggerBindingUpdate,
+		Delete: resourceAuth0TriggerBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"trigger": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(triggerBindingTriggers, false),
+			},
+			// The order of the list is the order in which the actions are executed by the trigger.
+			"actions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAuth0TriggerBindingCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	trigger := d.Get("trigger").(string)
+	bindingsRequest := createTriggerBindingsRequestFromResourceData(d)
+
+	_, err := auth0Client.UpdateTriggerBindings(trigger, bindingsRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to create auth0 trigger bindings: %v error: %v", bindingsRequest, err)
+	}
+
+	d.SetId(trigger)
+
+	return resourceAuth0TriggerBindingRead(d, meta)
+}
+
+func resourceAuth0TriggerBindingRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	bindings, err := auth0Client.GetTriggerBindings(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 trigger bindings: %v", err)
+	}
+
+	if bindings == nil {
+		d.SetId("")
+		return nil
+	}
+
+	actions := make([]interface{}, 0, len(bindings.Bindings))
+	for _, binding := range bindings.Bindings {
+		actions = append(actions, map[string]interface{}{
+			"id":           binding.Action.Id,
+			"display_name": binding.DisplayName,
+		})
+	}
+
+	d.Set("trigger", d.Id())
+	d.Set("actions", actions)
+
+	return nil
+}
+
+func resourceAuth0TriggerBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	bindingsRequest := createTriggerBindingsRequestFromResourceData(d)
+
+	_, err := auth0Client.UpdateTriggerBindings(d.Id(), bindingsRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to update auth0 trigger bindings: %v error: %v", bindingsRequest, err)
+	}
+
+	return resourceAuth0TriggerBindingRead(d, meta)
+}
+
+func resourceAuth0TriggerBindingDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	_, err := auth0Client.UpdateTriggerBindings(d.Id(), &TriggerBindingsRequest{Bindings: []TriggerBindingRequest{}})
+
+	if err != nil {
+		return fmt.Errorf("could not delete auth0 trigger bindings: %v", err)
+	}
+
+	return nil
+}
+
+func createTriggerBindingsRequestFromResourceData(d *schema.ResourceData) *TriggerBindingsRequest {
+	bindingsRequest := &TriggerBindingsRequest{Bindings: []TriggerBindingRequest{}}
+
+	for _, item := range d.Get("actions").([]interface{}) {
+		action := item.(map[string]interface{})
+
+		bindingsRequest.Bindings = append(bindingsRequest.Bindings, TriggerBindingRequest{
+			Ref: TriggerBindingRef{
+				Type:  "action_id",
+				Value: action["id"].(string),
+			},
+			DisplayName: action["display_name"].(string),
+		})
+	}
+
+	return bindingsRequest
+}
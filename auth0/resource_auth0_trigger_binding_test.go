@@ -0,0 +1,187 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0TriggerBinding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0TriggerBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTriggerBindingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0TriggerBindingExists("auth0_trigger_binding.test_binding"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "trigger", "post-change-password"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "auth0_trigger_binding.test_binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAuth0TriggerBindingActions(t *testing.T) {
+	firstActionId := os.Getenv("AUTH0_ACTION_ID")
+	secondActionId := os.Getenv("AUTH0_SECOND_ACTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckActionIds(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0TriggerBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testTriggerBindingActionsConfig, firstActionId, "first", secondActionId, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0TriggerBindingExists("auth0_trigger_binding.test_binding"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.#", "2"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.0.id", firstActionId),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.1.id", secondActionId),
+					testAccCheckAuth0TriggerBindingOrder("auth0_trigger_binding.test_binding", firstActionId, secondActionId),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testTriggerBindingActionsConfig, secondActionId, "second", firstActionId, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.#", "2"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.0.id", secondActionId),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.0.display_name", "second"),
+					resource.TestCheckResourceAttr("auth0_trigger_binding.test_binding", "actions.1.id", firstActionId),
+					testAccCheckAuth0TriggerBindingOrder("auth0_trigger_binding.test_binding", secondActionId, firstActionId),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckActionIds requires two deployed actions of the post-change-password trigger.
+func testAccPreCheckActionIds(t *testing.T) {
+	if v := os.Getenv("AUTH0_ACTION_ID"); v == "" {
+		t.Fatal("AUTH0_ACTION_ID must be set for trigger binding acceptance tests")
+	}
+
+	if v := os.Getenv("AUTH0_SECOND_ACTION_ID"); v == "" {
+		t.Fatal("AUTH0_SECOND_ACTION_ID must be set for trigger binding acceptance tests")
+	}
+}
+
+// testAccCheckAuth0TriggerBindingOrder checks the order in which Auth0 executes the actions bound to the trigger.
+func testAccCheckAuth0TriggerBindingOrder(resourceKey string, actionIds ...string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		bindings, err := client.GetTriggerBindings(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if bindings == nil || len(bindings.Bindings) != len(actionIds) {
+			return fmt.Errorf("expected %d bindings on trigger %s, got %+v", len(actionIds), rs.Primary.ID, bindings)
+		}
+
+		for i, actionId := range actionIds {
+			if bindings.Bindings[i].Action.Id != actionId {
+				return fmt.Errorf("expected action %s at position %d of trigger %s, got %s", actionId, i, rs.Primary.ID, bindings.Bindings[i].Action.Id)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAuth0TriggerBindingDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	bindings := getResourcesByType("auth0_trigger_binding", state)
+
+	if len(bindings) != 1 {
+		return fmt.Errorf("expecting only 1 auth0 trigger binding resource found %v", len(bindings))
+	}
+
+	response, err := client.GetTriggerBindings(bindings[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get auth0 trigger bindings: %v", err)
+	}
+
+	if response != nil && len(response.Bindings) > 0 {
+		return fmt.Errorf("trigger %s still has bindings, %+v", bindings[0].Primary.ID, response)
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0TriggerBindingExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		bindings, err := client.GetTriggerBindings(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if bindings == nil {
+			return fmt.Errorf("trigger %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateTriggerBindingConfig = `
+
+resource "auth0_trigger_binding" "test_binding" {
+	trigger = "post-change-password"
+}
+
+`
+
+const testTriggerBindingActionsConfig = `
+
+resource "auth0_trigger_binding" "test_binding" {
+	trigger = "post-change-password"
+
+	actions {
+		id 				= "%s"
+		display_name 	= "%s"
+	}
+
+	actions {
+		id 				= "%s"
+		display_name 	= "%s"
+	}
+}
+
+`
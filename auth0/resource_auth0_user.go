@@ -2,8 +2,12 @@ package auth0
 
 import (
 	"fmt"
+	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAuth0User() *schema.Resource {
@@ -13,7 +17,7 @@ func resourceAuth0User() *schema.Resource {
 		Update: resourceAuth0UserUpdate,
 		Delete: resourceAuth0UserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAuth0UserImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,25 +36,189 @@ func resourceAuth0User() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// Auth0 defaults the name to the email when it is not set.
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"password": &schema.Schema{
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ConflictsWith:    []string{"custom_password_hash"},
+				DiffSuppressFunc: suppressPasswordDiffWhenNotStored,
 			},
-			"user_metadata": &schema.Schema{
-				Type:     schema.TypeMap,
+			// When false password is left empty, so the plaintext never ends up in the state. Changes to the
+			// password are then only applied when password_version changes.
+			"store_password": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// Any change, e.g. bumping a number, sets the password again even when it didn't change.
+			"password_version": &schema.Schema{
+				Type:     schema.TypeString,
 				Optional: true,
-				Elem:     schema.TypeString,
-				Default:  nil,
+			},
+			// When the password was last changed in Auth0, which tells whether it was reset outside of Terraform.
+			"last_password_reset": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Password hash imported from another system when the user is created. Auth0 never returns it,
+			// so changes made after the user was created are ignored.
+			"custom_password_hash": &schema.Schema{
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressDiffAfterCreation,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"bcrypt", "argon2", "pbkdf2", "sha256"}, false),
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+						// For bcrypt, argon2 and pbkdf2 the hash is in its standard (modular crypt or PHC) format.
+						"hash": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+						"hash_encoding": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"base64", "hex", "utf8"}, false),
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+						"salt": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+						"salt_encoding": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"base64", "hex", "utf8"}, false),
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+						"salt_position": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringInSlice([]string{"prefix", "suffix"}, false),
+							DiffSuppressFunc: suppressDiffAfterCreation,
+						},
+					},
+				},
+			},
+			"user_metadata": &schema.Schema{
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          schema.TypeString,
+				Default:       nil,
+				ConflictsWith: []string{"user_metadata_json"},
+			},
+			// JSON object variant of user_metadata, for metadata holding nested objects or non string values.
+			"user_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ConflictsWith:    []string{"user_metadata"},
+			},
+			"app_metadata": &schema.Schema{
+				Type:          schema.TypeMap,
+				Optional:      true,
+				Elem:          schema.TypeString,
+				Default:       nil,
+				ConflictsWith: []string{"app_metadata_json"},
+			},
+			// JSON object variant of app_metadata, for metadata holding nested objects or non string values.
+			"app_metadata_json": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ConflictsWith:    []string{"app_metadata"},
 			},
 			"email_verified": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			// Whether Auth0 sends a verification email when the user is created or its email changes.
+			"verify_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			// Only valid for database connections which require a username.
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Only valid for sms connections, in E.164 format.
+			"phone_number": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`), "must be in E.164 format, e.g. +14155550100"),
+			},
+			"phone_verified": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"given_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"family_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Auth0 derives the nickname from the email when it is not set.
+			"nickname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Auth0 defaults the picture to a gravatar url when it is not set.
+			"picture": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Blocks the user from logging in, brute-force protection blocks are cleared with
+			// auth0_user_block_clearance instead.
+			"blocked": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"identities": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_social": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -59,7 +227,11 @@ func resourceAuth0UserCreate(d *schema.ResourceData, meta interface{}) error {
 
 	auth0Client := meta.(*AuthClient)
 
-	userRequest := createUserRequestFromResourceData(d)
+	userRequest, err := createUserRequestFromResourceData(d)
+
+	if err != nil {
+		return err
+	}
 
 	user, err := auth0Client.CreateUser(userRequest)
 	TfLogJson("[resourceAuth0UserCreate]", userRequest)
@@ -76,7 +248,12 @@ func resourceAuth0UserCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAuth0UserUpdate(d *schema.ResourceData, meta interface{}) error {
 	auth0Client := meta.(*AuthClient)
 
-	updateUserRequests := createUserUpdatesFromResourceData(d)
+	updateUserRequests, err := createUserUpdatesFromResourceData(d)
+
+	if err != nil {
+		return err
+	}
+
 	userId := d.Id()
 
 	for _, update := range updateUserRequests {
@@ -108,14 +285,31 @@ func resourceAuth0UserRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("user_id", user.UserId)
 		d.Set("email", user.Email)
 		d.Set("name", user.Name)
-		d.Set("user_metadata", user.UserMetaData)
+		if err := setUserMetadata(d, "user_metadata", user.UserMetaData); err != nil {
+			return err
+		}
+		if err := setUserMetadata(d, "app_metadata", user.AppMetaData); err != nil {
+			return err
+		}
 		d.Set("email_verified", user.EmailVerified)
+		d.Set("username", user.Username)
+		d.Set("phone_number", user.PhoneNumber)
+		d.Set("phone_verified", user.PhoneVerified)
+		d.Set("given_name", user.GivenName)
+		d.Set("family_name", user.FamilyName)
+		d.Set("nickname", user.Nickname)
+		d.Set("picture", user.Picture)
+		d.Set("blocked", user.Blocked)
+		d.Set("last_password_reset", user.LastPasswordReset)
+		if userPasswordNotStored(d) {
+			d.Set("password", "")
+		}
 
-		// TODO: We should model identities properly as more than one identity for a user
-		// might exist
+		// The first identity is the one the user was created with, the others were linked to it
 		if len(user.Identities) > 0 {
 			d.Set("connection_type", user.Identities[0].Connection)
 		}
+		d.Set("identities", flattenUserIdentities(user.Identities))
 
 		TfLogJson("[resourceAuth0UserRead]", user)
 	}
@@ -141,45 +335,370 @@ func resourceAuth0UserDelete(d *schema.ResourceData, meta interface{}) error {
 // Given ResourceData from terraform, generate a list of patches to apply sequentially.
 // We do this to reconcile state against the auth0 API, you can't update email_verified, and
 // passsword, or email in one API call for example, so it must be updated in multiple requests.
-func createUserUpdatesFromResourceData(d *schema.ResourceData) []*UserRequest {
+// The same goes for username and phone_number, which also need the connection to be set.
+// Only attributes which changed are patched, so that e.g. a metadata change doesn't re-set the password.
+func createUserUpdatesFromResourceData(d *schema.ResourceData) ([]*UserRequest, error) {
+
+	connection := readStringFromResource(d, "connection_type")
 
-	// The first patch contains everything but email_verified, and password state
+	userRequests := []*UserRequest{}
+
+	// The first patch contains the email and profile, but not the credentials or the verified states
 	userRequestA := &UserRequest{}
+	changedA := false
+
+	// Auth0 doesn't allow removing the email of a user, only changing it
+	if email := readStringFromResource(d, "email"); email != "" && d.HasChange("email") {
+		userRequestA.Email = email
+		userRequestA.VerifyEmail = readBoolPointerFromResource(d, "verify_email")
+		changedA = true
+	}
 
-	userRequestA.Connection = readStringFromResource(d, "connection_type")
-	userRequestA.Email = readStringFromResource(d, "email")
-	userRequestA.Name = readStringFromResource(d, "name")
-	userRequestA.UserMetaData = readMapFromResource(d, "user_metadata")
+	for key, field := range map[string]*string{
+		"given_name":  &userRequestA.GivenName,
+		"family_name": &userRequestA.FamilyName,
+	} {
+		changedA = readUserStringChange(d, userRequestA, key, field) || changedA
+	}
+
+	// Auth0 gives the name, nickname and picture a default value, so they are changed but never cleared
+	for key, field := range map[string]*string{
+		"name":     &userRequestA.Name,
+		"nickname": &userRequestA.Nickname,
+		"picture":  &userRequestA.Picture,
+	} {
+		if value := readStringFromResource(d, key); value != "" && d.HasChange(key) {
+			*field = value
+			changedA = true
+		}
+	}
+
+	if d.HasChange("blocked") {
+		blocked := readBoolFromResource(d, "blocked")
+		userRequestA.Blocked = &blocked
+		changedA = true
+	}
 
-	TfLogJson("[createUserUpdatesFromResourceData-userRequestA]", userRequestA)
+	// Auth0 merges metadata on update, removed keys have to be explicitly set to null
+	if d.HasChanges("user_metadata", "user_metadata_json") {
+		userMetaData, err := readUserMetadataPatch(d, "user_metadata")
+		if err != nil {
+			return nil, err
+		}
+		userRequestA.UserMetaData = userMetaData
+		changedA = true
+	}
 
-	// Second contains only the password
-	userRequestB := &UserRequest{}
-	userRequestB.Password = readStringFromResource(d, "password")
+	if d.HasChanges("app_metadata", "app_metadata_json") {
+		appMetaData, err := readUserMetadataPatch(d, "app_metadata")
+		if err != nil {
+			return nil, err
+		}
+		userRequestA.AppMetaData = appMetaData
+		changedA = true
+	}
 
-	TfLogJson("[createUserUpdatesFromResourceData-userRequestB]", userRequestB)
+	if changedA {
+		userRequestA.Connection = connection
 
-	// Final updates the email_verified state
+		TfLogJson("[createUserUpdatesFromResourceData-userRequestA]", userRequestA)
+
+		userRequests = append(userRequests, userRequestA)
+	}
+
+	// Second contains only the password, which is left as is when removed from the configuration
+	if password := readUserPassword(d); password != "" && d.HasChanges("password", "password_version") {
+		userRequestB := &UserRequest{}
+		userRequestB.Connection = connection
+		userRequestB.Password = password
+
+		TfLogString("[createUserUpdatesFromResourceData-userRequestB]", "password changed")
+
+		userRequests = append(userRequests, userRequestB)
+	}
+
+	// Then the username and phone number, each on its own as they are only valid for some connections
 	userRequestC := &UserRequest{}
-	userRequestC.EmailVerified = readBoolFromResource(d, "email_verified")
+	if readUserStringChange(d, userRequestC, "username", &userRequestC.Username) {
+		userRequestC.Connection = connection
+
+		TfLogJson("[createUserUpdatesFromResourceData-userRequestC]", userRequestC)
+
+		userRequests = append(userRequests, userRequestC)
+	}
+
+	userRequestD := &UserRequest{}
+	if readUserStringChange(d, userRequestD, "phone_number", &userRequestD.PhoneNumber) {
+		userRequestD.Connection = connection
+
+		TfLogJson("[createUserUpdatesFromResourceData-userRequestD]", userRequestD)
+
+		userRequests = append(userRequests, userRequestD)
+	}
+
+	// Final updates the email_verified and phone_verified states
+	userRequestE := &UserRequest{}
+	changedE := false
+
+	if d.HasChange("email_verified") {
+		userRequestE.EmailVerified = readBoolFromResource(d, "email_verified")
+		userRequestE.ForceSendFields = append(userRequestE.ForceSendFields, "email_verified")
+		changedE = true
+	}
+
+	if d.HasChange("phone_verified") && readStringFromResource(d, "phone_number") != "" {
+		phoneVerified := readBoolFromResource(d, "phone_verified")
+		userRequestE.PhoneVerified = &phoneVerified
+		changedE = true
+	}
+
+	if changedE {
+		userRequestE.Connection = connection
+
+		TfLogJson("[createUserUpdatesFromResourceData-userRequestE]", userRequestE)
+
+		userRequests = append(userRequests, userRequestE)
+	}
 
-	TfLogJson("[createUserUpdatesFromResourceData-userRequestC]", userRequestC)
+	return userRequests, nil
+}
+
+// readUserStringChange copies a changed string attribute into field, or marks it to be cleared in Auth0 when it
+// was removed from the configuration. It returns whether the attribute changed.
+func readUserStringChange(d *schema.ResourceData, userRequest *UserRequest, key string, field *string) bool {
+
+	if !d.HasChange(key) {
+		return false
+	}
+
+	if value := readStringFromResource(d, key); value != "" {
+		*field = value
+	} else {
+		userRequest.NullFields = append(userRequest.NullFields, key)
+	}
 
-	return []*UserRequest{userRequestA, userRequestB, userRequestC}
+	return true
 }
 
-func createUserRequestFromResourceData(d *schema.ResourceData) *UserRequest {
+func createUserRequestFromResourceData(d *schema.ResourceData) (*UserRequest, error) {
 
 	userRequest := &UserRequest{}
 
 	userRequest.Connection = readStringFromResource(d, "connection_type")
 	userRequest.Email = readStringFromResource(d, "email")
 	userRequest.Name = readStringFromResource(d, "name")
-	userRequest.Password = readStringFromResource(d, "password")
-	userRequest.UserMetaData = readMapFromResource(d, "user_metadata")
+	userRequest.Password = readUserPassword(d)
+	userRequest.CustomPasswordHash = expandCustomPasswordHash(d.Get("custom_password_hash").([]interface{}))
+	userRequest.Username = readStringFromResource(d, "username")
+	userRequest.PhoneNumber = readStringFromResource(d, "phone_number")
+	userRequest.PhoneVerified = readBoolPointerFromResource(d, "phone_verified")
+	userRequest.GivenName = readStringFromResource(d, "given_name")
+	userRequest.FamilyName = readStringFromResource(d, "family_name")
+	userRequest.Nickname = readStringFromResource(d, "nickname")
+	userRequest.Picture = readStringFromResource(d, "picture")
+	userRequest.Blocked = readBoolPointerFromResource(d, "blocked")
+	userRequest.VerifyEmail = readBoolPointerFromResource(d, "verify_email")
+
+	userMetaData, err := readUserMetadata(d, "user_metadata")
+	if err != nil {
+		return nil, err
+	}
+	userRequest.UserMetaData = userMetaData
+
+	appMetaData, err := readUserMetadata(d, "app_metadata")
+	if err != nil {
+		return nil, err
+	}
+	userRequest.AppMetaData = appMetaData
 	userRequest.EmailVerified = readBoolFromResource(d, "email_verified")
 
 	TfLogJson("[createUserRequestFromResourceData-userRequest]", userRequest)
 
-	return userRequest
+	return userRequest, nil
+}
+
+// readUserMetadata reads metadata from either the flat map attribute or its "_json" variant, whichever is set.
+func readUserMetadata(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+
+	if metadataJson := readStringFromResource(d, key+"_json"); metadataJson != "" {
+		metadata, err := structure.ExpandJsonFromString(metadataJson)
+		if err != nil {
+			return nil, fmt.Errorf("%s_json must be a JSON object: %v", key, err)
+		}
+
+		return metadata, nil
+	}
+
+	return readMapFromResource(d, key), nil
+}
+
+// readUserMetadataPatch returns the metadata to PATCH, Auth0 merges it with what it already holds so keys which
+// were removed from the configuration are set to nil. This also covers switching between the flat map attribute
+// and its "_json" variant.
+func readUserMetadataPatch(d *schema.ResourceData, key string) (map[string]interface{}, error) {
+
+	patch := map[string]interface{}{}
+
+	oldMap, _ := d.GetChange(key)
+	for k := range oldMap.(map[string]interface{}) {
+		patch[k] = nil
+	}
+
+	// The previous JSON has already been validated when it was applied.
+	oldJson, _ := d.GetChange(key + "_json")
+	if oldMetadata, err := structure.ExpandJsonFromString(oldJson.(string)); err == nil {
+		for k := range oldMetadata {
+			patch[k] = nil
+		}
+	}
+
+	metadata, err := readUserMetadata(d, key)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range metadata {
+		patch[k] = v
+	}
+
+	return patch, nil
+}
+
+// setUserMetadata sets the metadata read from Auth0 on the "_json" variant when it is in use, or on the flat map
+// attribute otherwise. Metadata holding nested objects or non string values can only be kept in the "_json" variant,
+// e.g. for imported users.
+func setUserMetadata(d *schema.ResourceData, key string, metadata map[string]interface{}) error {
+
+	if readStringFromResource(d, key+"_json") == "" {
+		if flat, ok := flattenUserMetadata(metadata); ok {
+			return d.Set(key, flat)
+		}
+	}
+
+	metadataJson, err := flattenUserMetadataJson(metadata)
+	if err != nil {
+		return fmt.Errorf("could not serialise %s: %v", key, err)
+	}
+
+	if err := d.Set(key, nil); err != nil {
+		return err
+	}
+
+	return d.Set(key+"_json", metadataJson)
+}
+
+// flattenUserMetadata returns the string values of metadata, and whether metadata only held string values.
+func flattenUserMetadata(metadata map[string]interface{}) (map[string]interface{}, bool) {
+	flat := map[string]interface{}{}
+	ok := true
+
+	for k, v := range metadata {
+		if value, isString := v.(string); isString {
+			flat[k] = value
+		} else {
+			ok = false
+		}
+	}
+
+	return flat, ok
+}
+
+func flattenUserMetadataJson(metadata map[string]interface{}) (string, error) {
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	return structure.FlattenJsonToString(metadata)
+}
+
+func flattenUserIdentities(identities []Identity) []interface{} {
+	result := make([]interface{}, 0, len(identities))
+
+	for _, identity := range identities {
+		result = append(result, map[string]interface{}{
+			"connection": identity.Connection,
+			"provider":   identity.Provider,
+			"user_id":    identity.UserId,
+			"is_social":  identity.IsSocial,
+		})
+	}
+
+	return result
+}
+
+func expandCustomPasswordHash(customPasswordHash []interface{}) *CustomPasswordHash {
+	if len(customPasswordHash) == 0 || customPasswordHash[0] == nil {
+		return nil
+	}
+
+	m := customPasswordHash[0].(map[string]interface{})
+
+	result := &CustomPasswordHash{
+		Algorithm: m["algorithm"].(string),
+		Hash: &CustomPasswordHashValue{
+			Value:    m["hash"].(string),
+			Encoding: m["hash_encoding"].(string),
+		},
+	}
+
+	if salt := m["salt"].(string); salt != "" {
+		result.Salt = &CustomPasswordHashSalt{
+			Value:    salt,
+			Encoding: m["salt_encoding"].(string),
+			Position: m["salt_position"].(string),
+		}
+	}
+
+	return result
+}
+
+// suppressDiffAfterCreation ignores changes to attributes which Auth0 only accepts when creating the user.
+func suppressDiffAfterCreation(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceAuth0UserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+
+	d.Set("store_password", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// userPasswordNotStored returns whether store_password is explicitly false. States written before store_password
+// existed don't hold it and d.Get reports false for them, their password has to be kept.
+func userPasswordNotStored(d *schema.ResourceData) bool {
+
+	if d.Get("store_password").(bool) {
+		return false
+	}
+
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if !raw.IsKnown() || raw.IsNull() {
+			continue
+		}
+
+		if storePassword := raw.GetAttr("store_password"); storePassword.IsKnown() && !storePassword.IsNull() && storePassword.False() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readUserPassword reads the password from the configuration, as it isn't in the state when store_password is false.
+func readUserPassword(d *schema.ResourceData) string {
+
+	if config := d.GetRawConfig(); !config.IsNull() {
+		if password := config.GetAttr("password"); password.IsKnown() && !password.IsNull() {
+			return password.AsString()
+		}
+	}
+
+	return readStringFromResource(d, "password")
+}
+
+// suppressPasswordDiffWhenNotStored ignores the difference between the configured password and the empty one kept
+// in the state when store_password is false.
+func suppressPasswordDiffWhenNotStored(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && userPasswordNotStored(d)
 }
@@ -0,0 +1,109 @@
+package auth0
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAuth0UserBlockClearance keeps a user clear of brute-force protection blocks. Blocks found when
+// refreshing make the clearance disappear from the state, so the next plan shows them being cleared again.
+// Users blocked through the blocked attribute of auth0_user are not affected.
+func resourceAuth0UserBlockClearance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0UserBlockClearanceCreate,
+		Read:   resourceAuth0UserBlockClearanceRead,
+		Delete: resourceAuth0UserBlockClearanceDelete,
+
+		// Imported using "user_id:<user_id>" or "identifier:<identifier>".
+		Importer: &schema.ResourceImporter{
+			State: resourceAuth0UserBlockClearanceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "identifier"},
+			},
+			// Email, username or phone number, every user with this identifier is cleared.
+			"identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0UserBlockClearanceCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	var err error
+
+	if userId := readStringFromResource(d, "user_id"); userId != "" {
+		err = auth0Client.UnblockUserByUserId(userId)
+		d.SetId("user_id:" + userId)
+	} else {
+		identifier := d.Get("identifier").(string)
+		err = auth0Client.UnblockUserByIdentifier(identifier)
+		d.SetId("identifier:" + identifier)
+	}
+
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("failed to clear auth0 user blocks: %v", err)
+	}
+
+	return resourceAuth0UserBlockClearanceRead(d, meta)
+}
+
+func resourceAuth0UserBlockClearanceRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	userBlocks, err := readUserBlocks(auth0Client, d)
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user blocks: %v", err)
+	}
+
+	if userBlocks == nil || len(userBlocks.BlockedFor) > 0 {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+// resourceAuth0UserBlockClearanceDelete only forgets the clearance, blocks which were cleared are not restored.
+func resourceAuth0UserBlockClearanceDelete(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAuth0UserBlockClearanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || (parts[0] != "user_id" && parts[0] != "identifier") || parts[1] == "" {
+		return nil, fmt.Errorf("invalid user block clearance id %q, expected user_id:<user_id> or identifier:<identifier>", d.Id())
+	}
+
+	d.Set(parts[0], parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// readUserBlocks gets the blocks of the user_id or identifier set on d, it returns nil when the user doesn't exist.
+func readUserBlocks(auth0Client *AuthClient, d *schema.ResourceData) (*UserBlocks, error) {
+
+	if userId := readStringFromResource(d, "user_id"); userId != "" {
+		return auth0Client.GetUserBlocksByUserId(userId)
+	}
+
+	return auth0Client.GetUserBlocksByIdentifier(d.Get("identifier").(string))
+}
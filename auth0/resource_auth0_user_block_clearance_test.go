@@ -0,0 +1,56 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0UserBlockClearance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUserBlockClearanceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "blocked", "true"),
+					resource.TestCheckResourceAttrPair("auth0_user_block_clearance.by_user_id", "user_id", "auth0_user.test_user", "id"),
+					resource.TestCheckResourceAttr("auth0_user_block_clearance.by_identifier", "id", "identifier:block-clearance@example.com"),
+					resource.TestCheckResourceAttr("data.auth0_user_blocks.test_blocks", "blocked_for.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "auth0_user_block_clearance.by_identifier",
+				ImportState:       true,
+				ImportStateId:     "identifier:block-clearance@example.com",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testCreateUserBlockClearanceConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "block-clearance@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	blocked 		= true
+}
+
+resource "auth0_user_block_clearance" "by_user_id" {
+	user_id = auth0_user.test_user.id
+}
+
+resource "auth0_user_block_clearance" "by_identifier" {
+	identifier = auth0_user.test_user.email
+}
+
+data "auth0_user_blocks" "test_blocks" {
+	user_id = auth0_user_block_clearance.by_user_id.user_id
+}
+
+`
@@ -0,0 +1,160 @@
+package auth0
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAuth0UserIdentityLink links the identity of a secondary user to a primary user. Auth0 removes the
+// secondary user once linked, so it shouldn't also be managed with auth0_user. Deleting the link turns the
+// identity back into a separate user.
+func resourceAuth0UserIdentityLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0UserIdentityLinkCreate,
+		Read:   resourceAuth0UserIdentityLinkRead,
+		Delete: resourceAuth0UserIdentityLinkDelete,
+
+		// Imported using "<primary_user_id>:<secondary_user_id>".
+		Importer: &schema.ResourceImporter{
+			State: resourceAuth0UserIdentityLinkImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"primary_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Full user_id of the secondary user, e.g. "google-oauth2|1234".
+			"secondary_user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUserIdWithProvider,
+			},
+			// Only needed when the provider has more than one connection, e.g. for database users.
+			"connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"connection_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_social": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0UserIdentityLinkCreate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	primaryUserId := d.Get("primary_user_id").(string)
+	secondaryUserId := d.Get("secondary_user_id").(string)
+	provider, userId := splitUserId(secondaryUserId)
+
+	linkRequest := &UserIdentityLinkRequest{
+		Provider:     provider,
+		UserId:       userId,
+		ConnectionId: readStringFromResource(d, "connection_id"),
+	}
+
+	_, err := auth0Client.LinkUserIdentity(primaryUserId, linkRequest)
+
+	if err != nil {
+		return fmt.Errorf("failed to link auth0 user %s to %s: %v", secondaryUserId, primaryUserId, err)
+	}
+
+	d.SetId(primaryUserId + ":" + secondaryUserId)
+
+	return resourceAuth0UserIdentityLinkRead(d, meta)
+}
+
+func resourceAuth0UserIdentityLinkRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	user, err := auth0Client.GetUserById(d.Get("primary_user_id").(string))
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user: %v", err)
+	}
+
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
+	provider, userId := splitUserId(d.Get("secondary_user_id").(string))
+
+	for _, identity := range user.Identities {
+		if identity.Provider == provider && identity.UserId == userId {
+			d.Set("connection_type", identity.Connection)
+			d.Set("is_social", identity.IsSocial)
+			return nil
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAuth0UserIdentityLinkDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	provider, userId := splitUserId(d.Get("secondary_user_id").(string))
+
+	err := auth0Client.UnlinkUserIdentity(d.Get("primary_user_id").(string), provider, userId)
+
+	if err != nil {
+		return fmt.Errorf("could not unlink auth0 user identity: %v", err)
+	}
+
+	return nil
+}
+
+func resourceAuth0UserIdentityLinkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if _, errs := validateUserIdWithProvider(parts[len(parts)-1], "secondary_user_id"); len(parts) != 2 || parts[0] == "" || errs != nil {
+		return nil, fmt.Errorf("invalid user identity link id %q, expected <primary_user_id>:<secondary_user_id>", d.Id())
+	}
+
+	d.Set("primary_user_id", parts[0])
+	d.Set("secondary_user_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// splitUserId splits a user_id such as "google-oauth2|1234" into its provider and the id within that provider.
+func splitUserId(userId string) (string, string) {
+	parts := strings.SplitN(userId, "|", 2)
+
+	if len(parts) != 2 {
+		return "", userId
+	}
+
+	return parts[0], parts[1]
+}
+
+func validateUserIdWithProvider(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if provider, userId := splitUserId(v); provider == "" || userId == "" {
+		return nil, []error{fmt.Errorf("expected %s to be a user_id in the form <provider>|<id>, got %q", k, v)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,98 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0UserIdentityLink(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserIdentityLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUserIdentityLinkConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserIdentityLinked("auth0_user_identity_link.test_link"),
+					resource.TestCheckResourceAttr("auth0_user_identity_link.test_link", "connection", "Username-Password-Authentication"),
+					resource.TestCheckResourceAttr("auth0_user_identity_link.test_link", "is_social", "false"),
+				),
+				// The secondary user no longer exists once its identity is linked to the primary user.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAuth0UserIdentityLinkDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*AuthClient)
+
+	for _, rs := range getResourcesByType("auth0_user", state) {
+		user, err := client.GetUserById(rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error calling get auth0 user by id: %v", err)
+		}
+
+		if user != nil {
+			return fmt.Errorf("user %s still exists, %+v", rs.Primary.ID, user)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAuth0UserIdentityLinked(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		user, err := client.GetUserById(rs.Primary.Attributes["primary_user_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			return fmt.Errorf("user with id %v not found", rs.Primary.Attributes["primary_user_id"])
+		}
+
+		if len(user.Identities) != 2 {
+			return fmt.Errorf("expected user %s to have 2 identities, found %d", user.UserId, len(user.Identities))
+		}
+
+		return nil
+	}
+}
+
+const testCreateUserIdentityLinkConfig = `
+
+resource "auth0_user" "primary" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "primary-identity@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+}
+
+resource "auth0_user" "secondary" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "secondary-identity@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+}
+
+resource "auth0_user_identity_link" "test_link" {
+	primary_user_id 	= auth0_user.primary.id
+	secondary_user_id 	= auth0_user.secondary.id
+}
+
+`
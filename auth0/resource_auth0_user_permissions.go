@@ -0,0 +1,188 @@
+package auth0
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAuth0UserPermissions manages the full set of permissions granted directly to a user, permissions
+// granted outside of Terraform are removed. Permissions the user gets through its roles are not affected.
+func resourceAuth0UserPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAuth0UserPermissionsCreate,
+		Read:   resourceAuth0UserPermissionsRead,
+		Update: resourceAuth0UserPermissionsUpdate,
+		Delete: resourceAuth0UserPermissionsDelete,
+
+		// Imported using the user id.
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permissions": permissionsSchema(),
+		},
+	}
+}
+
+func resourceAuth0UserPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId(d.Get("user_id").(string))
+
+	return resourceAuth0UserPermissionsUpdate(d, meta)
+}
+
+func resourceAuth0UserPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	permissions, err := auth0Client.GetUserPermissions(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user permissions: %v", err)
+	}
+
+	if permissions == nil {
+		d.SetId("")
+	} else {
+		d.Set("user_id", d.Id())
+		d.Set("permissions", flattenPermissions(permissions))
+	}
+
+	return nil
+}
+
+func resourceAuth0UserPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	current, err := auth0Client.GetUserPermissions(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find auth0 user permissions: %v", err)
+	}
+
+	if current == nil {
+		return fmt.Errorf("auth0 user %s not found", d.Id())
+	}
+
+	toAdd, toRemove := diffPermissions(current, expandPermissions(d.Get("permissions").(*schema.Set)))
+
+	if len(toRemove) > 0 {
+		if err := auth0Client.RemoveUserPermissions(d.Id(), toRemove); err != nil {
+			return fmt.Errorf("failed to remove permissions from auth0 user %s: %v", d.Id(), err)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := auth0Client.AddUserPermissions(d.Id(), toAdd); err != nil {
+			return fmt.Errorf("failed to add permissions to auth0 user %s: %v", d.Id(), err)
+		}
+	}
+
+	return resourceAuth0UserPermissionsRead(d, meta)
+}
+
+func resourceAuth0UserPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+
+	auth0Client := meta.(*AuthClient)
+
+	permissions := expandPermissions(d.Get("permissions").(*schema.Set))
+
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	err := auth0Client.RemoveUserPermissions(d.Id(), permissions)
+
+	if err != nil {
+		return fmt.Errorf("could not remove auth0 user permissions: %v", err)
+	}
+
+	return nil
+}
+
+func permissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_server_identifier": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandPermissions(set *schema.Set) []Permission {
+	permissions := []Permission{}
+
+	for _, item := range set.List() {
+		m := item.(map[string]interface{})
+		permissions = append(permissions, Permission{
+			ResourceServerIdentifier: m["resource_server_identifier"].(string),
+			PermissionName:           m["name"].(string),
+		})
+	}
+
+	return permissions
+}
+
+func flattenPermissions(permissions []Permission) []interface{} {
+	result := make([]interface{}, 0, len(permissions))
+
+	for _, permission := range permissions {
+		result = append(result, map[string]interface{}{
+			"resource_server_identifier": permission.ResourceServerIdentifier,
+			"name":                       permission.PermissionName,
+		})
+	}
+
+	return result
+}
+
+// diffPermissions returns the permissions to add and to remove to go from current to desired.
+func diffPermissions(current []Permission, desired []Permission) ([]Permission, []Permission) {
+	key := func(permission Permission) string {
+		return permission.ResourceServerIdentifier + " " + permission.PermissionName
+	}
+
+	currentKeys := map[string]bool{}
+	for _, permission := range current {
+		currentKeys[key(permission)] = true
+	}
+
+	desiredKeys := map[string]bool{}
+	toAdd := []Permission{}
+	for _, permission := range desired {
+		desiredKeys[key(permission)] = true
+		if !currentKeys[key(permission)] {
+			toAdd = append(toAdd, permission)
+		}
+	}
+
+	toRemove := []Permission{}
+	for _, permission := range current {
+		if !desiredKeys[key(permission)] {
+			toRemove = append(toRemove, Permission{
+				ResourceServerIdentifier: permission.ResourceServerIdentifier,
+				PermissionName:           permission.PermissionName,
+			})
+		}
+	}
+
+	return toAdd, toRemove
+}
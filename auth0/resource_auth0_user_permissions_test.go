@@ -0,0 +1,102 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAuth0UserPermissions(t *testing.T) {
+	managementApi := "https://" + os.Getenv("AUTH0_DOMAIN") + "/api/v2/"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateUserPermissionsConfig, managementApi, managementApi),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_user_permissions.test_permissions", "user_id", "auth0_user.test_user", "id"),
+					resource.TestCheckResourceAttr("auth0_user_permissions.test_permissions", "permissions.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testUpdateUserPermissionsConfig, managementApi),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_user_permissions.test_permissions", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("auth0_user_permissions.test_permissions", "permissions.*", map[string]string{
+						"resource_server_identifier": managementApi,
+						"name":                       "read:users",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDiffPermissions(t *testing.T) {
+	current := []Permission{
+		{ResourceServerIdentifier: "https://api.example.com/", PermissionName: "read:items", Description: "Read items"},
+		{ResourceServerIdentifier: "https://api.example.com/", PermissionName: "write:items"},
+	}
+	desired := []Permission{
+		{ResourceServerIdentifier: "https://api.example.com/", PermissionName: "read:items"},
+		{ResourceServerIdentifier: "https://other.example.com/", PermissionName: "write:items"},
+	}
+
+	toAdd, toRemove := diffPermissions(current, desired)
+
+	if len(toAdd) != 1 || toAdd[0] != desired[1] {
+		t.Fatalf("expected to only add %v, got %v", desired[1], toAdd)
+	}
+
+	if len(toRemove) != 1 || toRemove[0] != current[1] {
+		t.Fatalf("expected to only remove %v, got %v", current[1], toRemove)
+	}
+}
+
+const testCreateUserPermissionsConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "permissions@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+}
+
+resource "auth0_user_permissions" "test_permissions" {
+	user_id = auth0_user.test_user.id
+
+	permissions {
+		resource_server_identifier 	= "%s"
+		name 						= "read:users"
+	}
+
+	permissions {
+		resource_server_identifier 	= "%s"
+		name 						= "read:clients"
+	}
+}
+
+`
+
+const testUpdateUserPermissionsConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "permissions@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+}
+
+resource "auth0_user_permissions" "test_permissions" {
+	user_id = auth0_user.test_user.id
+
+	permissions {
+		resource_server_identifier 	= "%s"
+		name 						= "read:users"
+	}
+}
+
+`
@@ -24,7 +24,12 @@ func TestAccAuth0User(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_user.test_user", "password", "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata.item1", "value1"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata.item2", "value2"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata.tenant_id", "tenant1"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata.plan", "free"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "email_verified", "true"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "identities.#", "1"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "identities.0.provider", "auth0"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "identities.0.connection", "Username-Password-Authentication"),
 				),
 			},
 			{
@@ -37,6 +42,9 @@ func TestAccAuth0User(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_user.test_user", "password", "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata.item1", "value4"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata.item2", "value3"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata.%", "1"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata.tenant_id", "tenant2"),
+					testAccCheckAuth0UserAppMetadataKeyRemoved("auth0_user.test_user", "plan"),
 					resource.TestCheckResourceAttr("auth0_user.test_user", "email_verified", "true"),
 				),
 			},
@@ -44,6 +52,127 @@ func TestAccAuth0User(t *testing.T) {
 	})
 }
 
+func TestAccAuth0UserMetadataJson(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUserMetadataJsonConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata.%", "0"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata_json", `{"address":{"city":"London"},"logins":3}`),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata_json", `{"entitlements":["billing","reports"],"plan":"free"}`),
+				),
+			},
+			{
+				Config: testUpdateUserMetadataJsonConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "user_metadata_json", `{"address":{"city":"Paris"}}`),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "app_metadata_json", `{"entitlements":["billing"]}`),
+					testAccCheckAuth0UserAppMetadataKeyRemoved("auth0_user.test_user", "plan"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuth0UserProfile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUserProfileConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "given_name", "Jane"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "family_name", "Doe"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "nickname", "jd"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "picture", "https://example.com/jane.png"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "blocked", "false"),
+				),
+			},
+			{
+				Config: testUpdateUserProfileConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "given_name", "Janet"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "family_name", ""),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "nickname", "janet"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "blocked", "true"),
+				),
+			},
+			{
+				Config: testRemoveNameUserProfileConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "name", "Jane Doe"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "nickname", "janet"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuth0UserCustomPasswordHash(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUserCustomPasswordHashConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "custom_password_hash.0.algorithm", "sha256"),
+				),
+			},
+			{
+				// The hash is only used when creating the user, changing it must not plan any change.
+				Config:   testUpdateUserCustomPasswordHashConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAuth0UserPasswordNotStored(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAuth0UserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testUserPasswordNotStoredConfig, "8aabf4be-2ad5-48b6-84aa-3dcd112716f0", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "password", ""),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "store_password", "false"),
+					resource.TestCheckResourceAttrSet("auth0_user.test_user", "last_password_reset"),
+				),
+			},
+			{
+				// Without bumping the version a new password is not applied.
+				Config:   fmt.Sprintf(testUserPasswordNotStoredConfig, "0f2a8ee4-3c6c-4a8e-9b0a-6f8d7c1e2b3a", "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: fmt.Sprintf(testUserPasswordNotStoredConfig, "0f2a8ee4-3c6c-4a8e-9b0a-6f8d7c1e2b3a", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuth0UserExists("auth0_user.test_user"),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "password", ""),
+					resource.TestCheckResourceAttr("auth0_user.test_user", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAuth0UserImport(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    testAccProviders,
@@ -114,6 +243,31 @@ func testAccCheckAuth0UserExists(resourceKey string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckAuth0UserAppMetadataKeyRemoved(resourceKey string, key string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		client := testAccProvider.Meta().(*AuthClient)
+
+		user, err := client.GetUserById(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := user.AppMetaData[key]; ok {
+			return fmt.Errorf("app_metadata key %s of user %s was not removed", key, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 const testCreateUserConfig = `
 resource "auth0_user" "test_user" {
 	connection_type = "Username-Password-Authentication"
@@ -124,6 +278,10 @@ resource "auth0_user" "test_user" {
 		item1 = "value1"
 		item2 = "value2"
 	}
+	app_metadata 	= {
+		tenant_id 	= "tenant1"
+		plan 		= "free"
+	}
 	email_verified 	= true
 }
 `
@@ -138,6 +296,9 @@ resource "auth0_user" "test_user" {
 		item1 = "value4"
 		item2 = "value3"
 	}
+	app_metadata 	= {
+		tenant_id = "tenant2"
+	}
 	email_verified 	= true
 }
 `
@@ -153,6 +314,130 @@ resource "auth0_user" "test_user" {
 		item1 = "value4"
 		item2 = "value3"
 	}
+	app_metadata_json = jsonencode({
+		roles = ["admin"]
+	})
 	email_verified 	= true
 }
 `
+
+const testCreateUserMetadataJsonConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type 	= "Username-Password-Authentication"
+	email 				= "metadata-json@example.com"
+	name 				= "user1234"
+	password 			= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	user_metadata_json 	= jsonencode({
+		address = { city = "London" }
+		logins 	= 3
+	})
+	app_metadata_json 	= jsonencode({
+		plan 			= "free"
+		entitlements 	= ["billing", "reports"]
+	})
+}
+`
+
+const testUpdateUserMetadataJsonConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type 	= "Username-Password-Authentication"
+	email 				= "metadata-json@example.com"
+	name 				= "user1234"
+	password 			= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	user_metadata_json 	= jsonencode({
+		address = { city = "Paris" }
+	})
+	app_metadata_json 	= jsonencode({
+		entitlements = ["billing"]
+	})
+}
+`
+
+const testCreateUserProfileConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "profile@example.com"
+	name 			= "Jane Doe"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	given_name 		= "Jane"
+	family_name 	= "Doe"
+	nickname 		= "jd"
+	picture 		= "https://example.com/jane.png"
+	verify_email 	= false
+}
+`
+
+const testUpdateUserProfileConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "profile@example.com"
+	name 			= "Jane Doe"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	given_name 		= "Janet"
+	nickname 		= "janet"
+	picture 		= "https://example.com/jane.png"
+	verify_email 	= false
+	blocked 		= true
+}
+`
+
+const testRemoveNameUserProfileConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "profile@example.com"
+	password 		= "8aabf4be-2ad5-48b6-84aa-3dcd112716f0"
+	given_name 		= "Janet"
+	verify_email 	= false
+	blocked 		= true
+}
+`
+
+const testCreateUserCustomPasswordHashConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "custom-password-hash@example.com"
+
+	custom_password_hash {
+		algorithm 		= "sha256"
+		hash 			= "MunOnR8CvwEI7wvu71e5SOfIE2Bwbm7gwauA4wEAnZo="
+		hash_encoding 	= "base64"
+		salt 			= "legacy-salt"
+		salt_encoding 	= "utf8"
+		salt_position 	= "prefix"
+	}
+}
+`
+
+const testUpdateUserCustomPasswordHashConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type = "Username-Password-Authentication"
+	email 			= "custom-password-hash@example.com"
+
+	custom_password_hash {
+		algorithm 		= "sha256"
+		hash 			= "bm90IHRoZSBzYW1lIGhhc2g="
+		hash_encoding 	= "base64"
+		salt 			= "another-salt"
+		salt_encoding 	= "utf8"
+		salt_position 	= "prefix"
+	}
+}
+`
+
+const testUserPasswordNotStoredConfig = `
+
+resource "auth0_user" "test_user" {
+	connection_type 	= "Username-Password-Authentication"
+	email 				= "password-not-stored@example.com"
+	password 			= "%s"
+	password_version 	= "%s"
+	store_password 		= false
+}
+`
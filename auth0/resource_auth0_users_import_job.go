@@ -0,0 +1,238 @@
+package auth0
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	jobStatusCompleted = "completed"
+	jobStatusFailed    = "failed"
+)
+
+// resourceAuth0UsersImportJob imports users in bulk from a local JSON file and waits for the job to complete.
+// It uses the context aware functions so users which could not be imported are reported as warnings, without
+// failing the whole apply. The imported users are left in place when the resource is destroyed.
+func resourceAuth0UsersImportJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuth0UsersImportJobCreate,
+		ReadContext:   resourceAuth0UsersImportJobRead,
+		DeleteContext: resourceAuth0UsersImportJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Path of a JSON file holding an array of users, in the format documented by Auth0.
+			"users_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Set to filesha256(users_file) to import the users again whenever the file changes.
+			"users_file_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// Whether existing users are updated, otherwise they are reported as errors.
+			"upsert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"external_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"send_completion_email": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inserted": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"updated": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuth0UsersImportJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	auth0Client := meta.(*AuthClient)
+
+	usersFile := d.Get("users_file").(string)
+
+	users, err := os.ReadFile(usersFile)
+
+	if err != nil {
+		return diag.Errorf("could not read users file %s: %v", usersFile, err)
+	}
+
+	importRequest := &UsersImportJobRequest{
+		ConnectionId:        d.Get("connection_id").(string),
+		Users:               users,
+		Upsert:              d.Get("upsert").(bool),
+		ExternalId:          readStringFromResource(d, "external_id"),
+		SendCompletionEmail: d.Get("send_completion_email").(bool),
+	}
+
+	job, err := auth0Client.CreateUsersImportJob(importRequest)
+
+	if err != nil {
+		return diag.Errorf("failed to create auth0 users import job: %v", err)
+	}
+
+	d.SetId(job.Id)
+
+	job, err = waitForAuth0Job(ctx, auth0Client, job.Id, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return append(diag.Errorf("auth0 users import job %s did not complete: %v", d.Id(), err), usersImportJobErrors(auth0Client, d.Id())...)
+	}
+
+	setUsersImportJob(d, job)
+
+	return usersImportJobErrors(auth0Client, job.Id)
+}
+
+// usersImportJobErrors reports every user an import job failed to import as a warning.
+func usersImportJobErrors(auth0Client *AuthClient, id string) diag.Diagnostics {
+
+	jobErrors, err := auth0Client.GetJobErrors(id)
+
+	if err != nil {
+		return diag.Errorf("could not get errors of auth0 users import job %s: %v", id, err)
+	}
+
+	var diags diag.Diagnostics
+	for _, jobError := range jobErrors {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "user could not be imported",
+			Detail:   describeJobUserError(jobError),
+		})
+	}
+
+	return diags
+}
+
+func resourceAuth0UsersImportJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	auth0Client := meta.(*AuthClient)
+
+	job, err := auth0Client.GetJobById(d.Id())
+
+	if err != nil {
+		return diag.Errorf("could not find auth0 users import job: %v", err)
+	}
+
+	// Auth0 only keeps jobs for a limited time, the import still happened so the state is kept as is.
+	if job != nil {
+		setUsersImportJob(d, job)
+	}
+
+	return nil
+}
+
+func resourceAuth0UsersImportJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	d.SetId("")
+
+	return nil
+}
+
+func setUsersImportJob(d *schema.ResourceData, job *Job) {
+	d.Set("status", job.Status)
+
+	if job.Summary != nil {
+		d.Set("inserted", job.Summary.Inserted)
+		d.Set("updated", job.Summary.Updated)
+		d.Set("failed", job.Summary.Failed)
+		d.Set("total", job.Summary.Total)
+	}
+}
+
+// waitForAuth0Job polls a job until it completed, a failed job is returned as an error.
+func waitForAuth0Job(ctx context.Context, auth0Client *AuthClient, id string, timeout time.Duration) (*Job, error) {
+
+	var job *Job
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		job, err = auth0Client.GetJobById(id)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if job == nil {
+			return resource.NonRetryableError(fmt.Errorf("job %s not found", id))
+		}
+
+		switch job.Status {
+		case jobStatusCompleted:
+			return nil
+		case jobStatusFailed:
+			return resource.NonRetryableError(fmt.Errorf("job %s failed", id))
+		default:
+			return resource.RetryableError(fmt.Errorf("job %s is not completed yet, status: %s", id, job.Status))
+		}
+	})
+
+	return job, err
+}
+
+func describeJobUserError(jobError JobUserError) string {
+	user := "unknown user"
+	for _, key := range []string{"email", "user_id", "username"} {
+		if value, ok := jobError.User[key].(string); ok && value != "" {
+			user = value
+			break
+		}
+	}
+
+	messages := []string{}
+	for _, err := range jobError.Errors {
+		message := err.Code + ": " + err.Message
+		if err.Path != "" {
+			message += " (" + err.Path + ")"
+		}
+		messages = append(messages, message)
+	}
+
+	return user + ": " + strings.Join(messages, ", ")
+}
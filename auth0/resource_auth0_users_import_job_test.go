@@ -0,0 +1,150 @@
+package auth0
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAuth0UsersImportJob(t *testing.T) {
+	usersFile := filepath.Join(t.TempDir(), "users.json")
+
+	err := os.WriteFile(usersFile, []byte(testUsersImportJobUsers), 0600)
+	if err != nil {
+		t.Fatalf("could not write users file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckConnectionId(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateUsersImportJobConfig, os.Getenv("AUTH0_CONNECTION_ID"), usersFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("auth0_users_import_job.test_import", "id"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "status", "completed"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "total", "2"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "failed", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuth0UsersImportJobInvalidUser(t *testing.T) {
+	usersFile := filepath.Join(t.TempDir(), "users.json")
+
+	err := os.WriteFile(usersFile, []byte(testUsersImportJobInvalidUsers), 0600)
+	if err != nil {
+		t.Fatalf("could not write users file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckConnectionId(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateUsersImportJobConfig, os.Getenv("AUTH0_CONNECTION_ID"), usersFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "status", "completed"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "total", "2"),
+					resource.TestCheckResourceAttr("auth0_users_import_job.test_import", "failed", "1"),
+					testAccCheckUsersImportJobWarns("auth0_users_import_job.test_import", "import-job-invalid"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUsersImportJobWarns checks that the users which failed to import are reported as warnings.
+func testAccCheckUsersImportJobWarns(resourceKey string, user string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		diags := usersImportJobErrors(testAccProvider.Meta().(*AuthClient), rs.Primary.ID)
+
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			return fmt.Errorf("expected a single warning for users import job %s, got %+v", rs.Primary.ID, diags)
+		}
+
+		if !strings.HasPrefix(diags[0].Detail, user+": ") {
+			return fmt.Errorf("expected the warning to be about %s, got %q", user, diags[0].Detail)
+		}
+
+		return nil
+	}
+}
+
+func TestDescribeJobUserError(t *testing.T) {
+	for _, test := range []struct {
+		jobError JobUserError
+		expected string
+	}{
+		{
+			jobError: JobUserError{
+				User: map[string]interface{}{"email": "jane@example.com", "user_id": "123"},
+				Errors: []JobError{
+					{Code: "INVALID_FORMAT", Message: "Object didn't pass validation for format email", Path: "email"},
+					{Code: "CONFLICT", Message: "The user already exists"},
+				},
+			},
+			expected: "jane@example.com: INVALID_FORMAT: Object didn't pass validation for format email (email), CONFLICT: The user already exists",
+		},
+		{
+			jobError: JobUserError{
+				User:   map[string]interface{}{"email": "", "username": "jane"},
+				Errors: []JobError{{Code: "CONFLICT", Message: "The user already exists"}},
+			},
+			expected: "jane: CONFLICT: The user already exists",
+		},
+		{
+			jobError: JobUserError{
+				User:   map[string]interface{}{"user_id": 123},
+				Errors: []JobError{{Code: "INVALID_TYPE", Message: "Expected type string", Path: "user_id"}},
+			},
+			expected: "unknown user: INVALID_TYPE: Expected type string (user_id)",
+		},
+	} {
+		if actual := describeJobUserError(test.jobError); actual != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, actual)
+		}
+	}
+}
+
+// testAccPreCheckConnectionId requires the id of a database connection users can be imported into.
+func testAccPreCheckConnectionId(t *testing.T) {
+	if v := os.Getenv("AUTH0_CONNECTION_ID"); v == "" {
+		t.Fatal("AUTH0_CONNECTION_ID must be set for user import acceptance tests")
+	}
+}
+
+const testUsersImportJobUsers = `[
+	{"email": "import-job-1@example.com", "email_verified": true, "name": "Import Job 1"},
+	{"email": "import-job-2@example.com", "email_verified": false, "name": "Import Job 2"}
+]`
+
+const testUsersImportJobInvalidUsers = `[
+	{"email": "import-job-3@example.com", "email_verified": true, "name": "Import Job 3"},
+	{"email": "import-job-invalid", "email_verified": true, "name": "Import Job Invalid"}
+]`
+
+const testCreateUsersImportJobConfig = `
+
+resource "auth0_users_import_job" "test_import" {
+	connection_id 	= "%s"
+	users_file 		= "%s"
+	upsert 			= true
+}
+
+`
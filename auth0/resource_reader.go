@@ -1,6 +1,9 @@
 package auth0
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func readStringFromResource(d *schema.ResourceData, key string) string {
 	if attr, ok := d.GetOk(key); ok {
@@ -41,3 +44,53 @@ func readStringArrayFromResource(d *schema.ResourceData, key string) []string {
 
 	return nil
 }
+
+func readStringSetFromResource(d *schema.ResourceData, key string) []string {
+
+	array := []string{}
+
+	if attr, ok := d.GetOk(key); ok {
+		for _, x := range attr.(*schema.Set).List() {
+			array = append(array, x.(string))
+		}
+	}
+
+	return array
+}
+
+// readStringArrayOrEmptyFromResource behaves like readStringArrayFromResource but returns an empty array when the
+// attribute is not set, so that removing every item from a list also clears it in Auth0.
+func readStringArrayOrEmptyFromResource(d *schema.ResourceData, key string) []string {
+
+	if array := readStringArrayFromResource(d, key); array != nil {
+		return array
+	}
+
+	return []string{}
+}
+
+// readBoolPointerFromResource returns nil when the attribute is absent from the configuration, unlike
+// readBoolFromResource which cannot tell an unset attribute from false.
+func readBoolPointerFromResource(d *schema.ResourceData, key string) *bool {
+
+	if config := d.GetRawConfig(); config.IsNull() || config.GetAttr(key).IsNull() {
+		return nil
+	}
+
+	value := d.Get(key).(bool)
+	return &value
+}
+
+// isEmptyListInConfig returns whether the list attribute is explicitly set to an empty list in the configuration,
+// which the state of an Optional and Computed attribute cannot tell apart from an unset one.
+func isEmptyListInConfig(d interface{ GetRawConfig() cty.Value }, key string) bool {
+
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return false
+	}
+
+	list := config.GetAttr(key)
+
+	return list.IsKnown() && !list.IsNull() && list.LengthInt() == 0
+}